@@ -78,6 +78,9 @@ func main() {
 	// 非必需，但如果需要复用连接时的gorm.Config或需要连接数据库同步表信息则必须设置
 	g.UseDB(connectDB(bc.Data.Database))
 
+	// delete_at生成为gorm.DeletedAt，查询时自动过滤已逻辑删除的数据，Delete也会变成逻辑删除
+	g.WithOpts(gen.FieldType("delete_at", "gorm.DeletedAt"))
+
 	// 从连接的数据库为所有表生成Model结构体和CRUD代码
 	// 也可以手动指定需要生成代码的数据表
 	g.ApplyBasic(g.GenerateAllTable()...)
@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"review-service/internal/conf"
+	"review-service/internal/server"
 	"review-service/pkg/snowflake"
 
 	"github.com/go-kratos/kratos/v2"
@@ -35,7 +36,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, r registry.Registrar, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, r registry.Registrar, gs *grpc.Server, hs *http.Server, job *server.DefaultReviewJob, autoReplyJob *server.AutoReplyJob, eventRelayJob *server.EventRelayJob) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -45,6 +46,9 @@ func newApp(logger log.Logger, r registry.Registrar, gs *grpc.Server, hs *http.S
 		kratos.Server(
 			gs,
 			hs,
+			job,           // 默认好评后台任务
+			autoReplyJob,  // 商家自动回复后台任务
+			eventRelayJob, // 发件箱事件发送后台任务
 		),
 		kratos.Registrar(r), // 服务注册 最终还是通过这个进行服务注册
 	)
@@ -82,7 +86,7 @@ func main() {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, &rc, bc.Data, bc.Elasticsearch, logger)
+	app, cleanup, err := wireApp(bc.Server, &rc, bc.Data, bc.Elasticsearch, bc.Review, logger)
 	if err != nil {
 		panic(err)
 	}
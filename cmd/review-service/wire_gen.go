@@ -23,7 +23,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Data, elasticsearch *conf.Elasticsearch, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Data, elasticsearch *conf.Elasticsearch, review *conf.Review, logger log.Logger) (*kratos.App, func(), error) {
 	registrar := server.NewRegistrar(registry)
 	db, err := data.NewDB(confData)
 	if err != nil {
@@ -39,12 +39,76 @@ func wireApp(confServer *conf.Server, registry *conf.Registry, confData *conf.Da
 		return nil, nil, err
 	}
 	reviewRepo := data.NewReviewRepo(dataData, logger)
-	reviewUsecase := biz.NewReviewUsecase(reviewRepo, logger)
-	reviewService := service.NewReviewService(reviewUsecase)
+	discovery, err := data.NewDiscovery(registry)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	orderClient, cleanup2, err := data.NewOrderServiceClient(discovery)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	bizOrderClient := data.NewOrderClient(orderClient, logger)
+	goodsClient, cleanup3, err := data.NewGoodsServiceClient(discovery)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	bizGoodsClient := data.NewGoodsClient(goodsClient, logger)
+	moderator, err := biz.NewModerator(review, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	spamRepo := data.NewSpamRepo(dataData, logger)
+	spamChecker := biz.NewSpamChecker(spamRepo, review, logger)
+	leaseRepo := data.NewLeaseRepo(dataData, logger)
+	auditQueue := biz.NewAuditQueue(leaseRepo, review, logger)
+	appealReasonCatalog, err := biz.NewAppealReasonCatalog(review, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	reviewUsecase := biz.NewReviewUsecase(reviewRepo, bizOrderClient, bizGoodsClient, moderator, spamChecker, auditQueue, appealReasonCatalog, review, logger)
+	autoReplyRepo := data.NewAutoReplyRepo(dataData, logger)
+	userClient, cleanup4, err := data.NewUserServiceClient(discovery)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	bizUserClient := data.NewUserClient(userClient, logger)
+	autoReplyUsecase := biz.NewAutoReplyUsecase(autoReplyRepo, reviewUsecase, bizUserClient, review, logger)
+	reviewService := service.NewReviewService(reviewUsecase, autoReplyUsecase)
 	grpcServer := server.NewGRPCServer(confServer, reviewService, logger)
 	httpServer := server.NewHTTPServer(confServer, reviewService, logger)
-	app := newApp(logger, registrar, grpcServer, httpServer)
+	orderSource, err := data.NewOrderSource(review, logger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	defaultReviewUsecase := biz.NewDefaultReviewUsecase(reviewRepo, orderSource, review, logger)
+	defaultReviewJob := server.NewDefaultReviewJob(review, defaultReviewUsecase, leaseRepo, logger)
+	autoReplyJob := server.NewAutoReplyJob(review, autoReplyUsecase, leaseRepo, logger)
+	outboxRepo := data.NewOutboxRepo(dataData, logger)
+	eventPublisher := data.NewEventPublisher(dataData, review, logger)
+	eventRelay := biz.NewEventRelay(outboxRepo, eventPublisher, review, logger)
+	eventRelayJob := server.NewEventRelayJob(review, eventRelay, leaseRepo, logger)
+	app := newApp(logger, registrar, grpcServer, httpServer, defaultReviewJob, autoReplyJob, eventRelayJob)
 	return app, func() {
+		cleanup4()
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,63 @@
+package biz
+
+import (
+	"context"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/data/model"
+)
+
+// WithdrawAppeal 商家撤回待审核的申诉，撤回后可以重新申诉
+func (uc *ReviewUsecase) WithdrawAppeal(ctx context.Context, appealID, storeID int64) error {
+	uc.log.WithContext(ctx).Debugf("[biz] WithdrawAppeal appealID:%d storeID:%d", appealID, storeID)
+	appeal, err := uc.repo.GetAppeal(ctx, appealID)
+	if err != nil {
+		return err
+	}
+	// 水平越权校验：只能撤回自己店铺的申诉
+	if appeal.StoreID != storeID {
+		return v1.ErrorStoreForbidden("店铺:%d无权操作申诉:%d", storeID, appealID)
+	}
+	if err := AppealStatusMachine.Transit(appeal.Status, AppealStatusWithdrawn); err != nil {
+		return err
+	}
+	return uc.repo.WithdrawAppeal(ctx, appeal)
+}
+
+// ListAppealsByStoreID B端分页查询店铺的申诉
+func (uc *ReviewUsecase) ListAppealsByStoreID(ctx context.Context, param *ListAppealParam) ([]*model.ReviewAppealInfo, int64, error) {
+	if param.StoreID <= 0 {
+		return nil, 0, v1.ErrorParamInvalid("店铺ID不能为空")
+	}
+	return uc.ListAppeals(ctx, param)
+}
+
+// ListAppeals 按条件分页查询申诉，按申诉时间倒序
+func (uc *ReviewUsecase) ListAppeals(ctx context.Context, param *ListAppealParam) ([]*model.ReviewAppealInfo, int64, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListAppeals param:%v", param)
+	if !param.StartTime.IsZero() && !param.EndTime.IsZero() && param.EndTime.Before(param.StartTime) {
+		return nil, 0, v1.ErrorParamInvalid("结束时间不能早于开始时间")
+	}
+	if param.Page <= 0 {
+		param.Page = 1
+	}
+	if param.Size <= 0 || param.Size > 50 {
+		param.Size = 10
+	}
+	return uc.repo.ListAppeals(ctx, param, (param.Page-1)*param.Size, param.Size)
+}
+
+// ListAppealHistory 查询评价的全部申诉记录，只有评价所属的商家和运营可以查看
+func (uc *ReviewUsecase) ListAppealHistory(ctx context.Context, param *GetReviewParam) ([]*model.ReviewAppealInfo, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListAppealHistory param:%v", param)
+	caller := CallerFromContext(ctx)
+	if !caller.IsOperator() {
+		review, err := uc.repo.GetReview(ctx, param.ReviewID)
+		if err != nil {
+			return nil, err
+		}
+		if !caller.IsMerchantOf(review.StoreID) {
+			return nil, v1.ErrorStoreForbidden("店铺:%d无权查看评价:%d的申诉", caller.StoreID, param.ReviewID)
+		}
+	}
+	return uc.repo.ListAppealByReviewID(ctx, param.ReviewID)
+}
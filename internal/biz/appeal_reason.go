@@ -0,0 +1,159 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/conf"
+	"sort"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// defaultLang 没有指定语言或者原因没有配置该语言时使用的显示语言
+const defaultLang = "zh-CN"
+
+// AppealReason 申诉原因类别
+type AppealReason struct {
+	Code           string
+	Labels         map[string]string // 语言 -> 显示名称
+	RequireContent bool              // 需要填写申诉内容
+	RequirePicture bool              // 需要上传图片
+	RequireVideo   bool              // 需要上传视频
+}
+
+// Label 返回指定语言的显示名称，依次回退到默认语言和原因编码
+func (r *AppealReason) Label(lang string) string {
+	if l := r.Labels[lang]; l != "" {
+		return l
+	}
+	if l := r.Labels[defaultLang]; l != "" {
+		return l
+	}
+	return r.Code
+}
+
+// AppealReasonCatalog 配置的申诉原因类别，按配置顺序排列
+type AppealReasonCatalog struct {
+	reasons []*AppealReason
+	byCode  map[string]*AppealReason
+}
+
+func NewAppealReasonCatalog(cfg *conf.Review, logger log.Logger) (*AppealReasonCatalog, error) {
+	c := &AppealReasonCatalog{byCode: make(map[string]*AppealReason)}
+	for _, r := range cfg.GetAppealReasons() {
+		code := strings.TrimSpace(r.GetCode())
+		if code == "" {
+			return nil, errors.New("appeal reason code is empty")
+		}
+		if _, ok := c.byCode[code]; ok {
+			return nil, fmt.Errorf("duplicate appeal reason code:%s", code)
+		}
+		reason := &AppealReason{
+			Code:           code,
+			Labels:         r.GetLabels(),
+			RequireContent: r.GetRequireContent(),
+			RequirePicture: r.GetRequirePicture(),
+			RequireVideo:   r.GetRequireVideo(),
+		}
+		c.reasons = append(c.reasons, reason)
+		c.byCode[code] = reason
+	}
+	if len(c.reasons) == 0 {
+		log.NewHelper(logger).Warn("[biz] appeal reasons not configured, appeal reason will not be validated")
+	}
+	return c, nil
+}
+
+// List 返回全部申诉原因
+func (c *AppealReasonCatalog) List() []*AppealReason {
+	return c.reasons
+}
+
+// Validate 校验申诉原因存在并且提供了该原因要求的材料
+// 没有配置申诉原因时不做校验
+func (c *AppealReasonCatalog) Validate(param *AppealParam) error {
+	if len(c.reasons) == 0 {
+		return nil
+	}
+	reason := c.byCode[param.Reason]
+	if reason == nil {
+		return v1.ErrorParamInvalid("无效的申诉原因:%s", param.Reason)
+	}
+	if reason.RequireContent && strings.TrimSpace(param.Content) == "" {
+		return v1.ErrorParamInvalid("申诉原因[%s]需要填写申诉内容", reason.Label(defaultLang))
+	}
+	if reason.RequirePicture && param.PicInfo == "" {
+		return v1.ErrorParamInvalid("申诉原因[%s]需要上传图片", reason.Label(defaultLang))
+	}
+	if reason.RequireVideo && param.VideoInfo == "" {
+		return v1.ErrorParamInvalid("申诉原因[%s]需要上传视频", reason.Label(defaultLang))
+	}
+	return nil
+}
+
+// AppealReasonCount 按申诉原因和状态分组的申诉数
+type AppealReasonCount struct {
+	Reason string
+	Status int32
+	Count  int64
+}
+
+// AppealReasonStat 一种申诉原因的统计
+type AppealReasonStat struct {
+	Reason    *AppealReason
+	Total     int64
+	Pending   int64
+	Approved  int64
+	Rejected  int64
+	Withdrawn int64
+}
+
+// ListAppealReasons 查询配置的申诉原因
+func (uc *ReviewUsecase) ListAppealReasons() []*AppealReason {
+	return uc.reasons.List()
+}
+
+// GetAppealReasonStats 按申诉原因统计申诉数量和审核结果
+// 结果按配置的原因顺序排列，不在配置中的原因（配置调整前的数据）按申诉数倒序排在最后
+func (uc *ReviewUsecase) GetAppealReasonStats(ctx context.Context, param *AppealReasonStatParam) ([]*AppealReasonStat, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] GetAppealReasonStats param:%v", param)
+	if !param.StartTime.IsZero() && !param.EndTime.IsZero() && param.EndTime.Before(param.StartTime) {
+		return nil, v1.ErrorParamInvalid("结束时间不能早于开始时间")
+	}
+	counts, err := uc.repo.CountAppealByReason(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	byCode := make(map[string]*AppealReasonStat)
+	ret := make([]*AppealReasonStat, 0, len(uc.reasons.List()))
+	for _, reason := range uc.reasons.List() {
+		stat := &AppealReasonStat{Reason: reason}
+		byCode[reason.Code] = stat
+		ret = append(ret, stat)
+	}
+	var unknown []*AppealReasonStat
+	for _, c := range counts {
+		stat, ok := byCode[c.Reason]
+		if !ok {
+			stat = &AppealReasonStat{Reason: &AppealReason{Code: c.Reason}}
+			byCode[c.Reason] = stat
+			unknown = append(unknown, stat)
+		}
+		stat.Total += c.Count
+		switch c.Status {
+		case AppealStatusPending:
+			stat.Pending += c.Count
+		case AppealStatusApproved:
+			stat.Approved += c.Count
+		case AppealStatusRejected:
+			stat.Rejected += c.Count
+		case AppealStatusWithdrawn:
+			stat.Withdrawn += c.Count
+		}
+	}
+	sort.SliceStable(unknown, func(i, j int) bool { return unknown[i].Total > unknown[j].Total })
+	return append(ret, unknown...), nil
+}
@@ -0,0 +1,86 @@
+package biz
+
+import (
+	"review-service/internal/conf"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestNewAppealReasonCatalog(t *testing.T) {
+	tests := []struct {
+		name    string
+		reasons []*conf.Review_AppealReason
+		wantErr bool
+	}{
+		{"没有配置", nil, false},
+		{"正常配置", []*conf.Review_AppealReason{{Code: "malicious"}, {Code: "fake"}}, false},
+		{"编码为空", []*conf.Review_AppealReason{{Code: "malicious"}, {Code: " "}}, true},
+		{"编码重复", []*conf.Review_AppealReason{{Code: "malicious"}, {Code: " malicious "}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewAppealReasonCatalog(&conf.Review{AppealReasons: tt.reasons}, log.DefaultLogger)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewAppealReasonCatalog() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			// 配置错误是启动错误，不是接口的参数错误
+			if err != nil && errors.FromError(err).Reason != errors.UnknownReason {
+				t.Errorf("NewAppealReasonCatalog() error = %v, want a plain error", err)
+			}
+		})
+	}
+}
+
+func TestAppealReasonCatalogValidate(t *testing.T) {
+	catalog, err := NewAppealReasonCatalog(&conf.Review{AppealReasons: []*conf.Review_AppealReason{
+		{Code: "other"},
+		{Code: "malicious", RequireContent: true},
+		{Code: "fake_picture", RequirePicture: true},
+		{Code: "fake_video", RequireVideo: true},
+		{Code: "all", RequireContent: true, RequirePicture: true, RequireVideo: true},
+	}}, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		param   *AppealParam
+		wantErr bool
+	}{
+		{"不要求材料", &AppealParam{Reason: "other"}, false},
+		{"未知原因", &AppealParam{Reason: "unknown", Content: "x"}, true},
+		{"原因为空", &AppealParam{}, true},
+		{"require_content有内容", &AppealParam{Reason: "malicious", Content: "恶意差评"}, false},
+		{"require_content没有内容", &AppealParam{Reason: "malicious"}, true},
+		{"require_content内容全是空白", &AppealParam{Reason: "malicious", Content: " \n"}, true},
+		{"require_picture有图片", &AppealParam{Reason: "fake_picture", PicInfo: "a.jpg"}, false},
+		{"require_picture没有图片", &AppealParam{Reason: "fake_picture", Content: "x", VideoInfo: "a.mp4"}, true},
+		{"require_video有视频", &AppealParam{Reason: "fake_video", VideoInfo: "a.mp4"}, false},
+		{"require_video没有视频", &AppealParam{Reason: "fake_video", Content: "x", PicInfo: "a.jpg"}, true},
+		{"全部要求都满足", &AppealParam{Reason: "all", Content: "x", PicInfo: "a.jpg", VideoInfo: "a.mp4"}, false},
+		{"全部要求缺少一项", &AppealParam{Reason: "all", Content: "x", PicInfo: "a.jpg"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := catalog.Validate(tt.param)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAppealReasonCatalogValidateEmpty(t *testing.T) {
+	catalog, err := NewAppealReasonCatalog(&conf.Review{}, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 没有配置申诉原因时任何原因都可以
+	for _, reason := range []string{"", "unknown"} {
+		if err := catalog.Validate(&AppealParam{Reason: reason}); err != nil {
+			t.Errorf("Validate(%q) error = %v, want nil", reason, err)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package biz
+
+import (
+	"context"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/conf"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 审核任务类型
+const (
+	AuditTaskReview = 1 // 审核评价
+	AuditTaskAppeal = 2 // 审核申诉
+)
+
+// 没有配置时的默认值
+const (
+	defaultLeaseDuration = 10 * time.Minute
+	defaultMaxClaim      = 20
+)
+
+// claimScanSize 领取任务时每次从数据库查询的待审核记录数
+const claimScanSize = 100
+
+// claimScanLimit 领取任务时最多扫描的待审核记录数，避免待审核记录都被别人领取时扫描全表
+const claimScanLimit = 1000
+
+// Lease 审核任务的租约
+type Lease struct {
+	Owner    string
+	ExpireAt time.Time
+}
+
+// LeaseRepo 保存审核任务的租约，租约过期后自动失效
+type LeaseRepo interface {
+	// AcquireLease 领取任务，任务已被领取时返回false
+	AcquireLease(ctx context.Context, taskType int32, taskID int64, owner string, ttl time.Duration) (bool, error)
+	// GetLeases 批量查询任务的租约，没有被领取的任务不在返回值中
+	GetLeases(ctx context.Context, taskType int32, taskIDs []int64) (map[int64]*Lease, error)
+	// ReleaseLease 释放owner持有的租约
+	ReleaseLease(ctx context.Context, taskType int32, taskID int64, owner string) error
+	// RenewLease 延长owner持有的租约，租约已过期或者被别人持有时返回false
+	RenewLease(ctx context.Context, taskType int32, taskID int64, owner string, ttl time.Duration) (bool, error)
+}
+
+// AuditTask 审核任务
+type AuditTask struct {
+	TaskType int32
+	TaskID   int64 // 评价ID或申诉ID
+	ReviewID int64
+	StoreID  int64
+	Content  string
+	CreateAt time.Time
+	Lease    *Lease // 没有被领取时为nil
+}
+
+// AuditQueue 审核任务队列
+// 运营领取一批待审核的评价或申诉，租约有效期内只有领取人能审核，租约过期后任务回到待领取
+type AuditQueue struct {
+	leases        LeaseRepo
+	leaseDuration time.Duration
+	maxClaim      int
+	log           *log.Helper
+}
+
+func NewAuditQueue(leases LeaseRepo, cfg *conf.Review, logger log.Logger) *AuditQueue {
+	c := cfg.GetAudit()
+	q := &AuditQueue{
+		leases:        leases,
+		leaseDuration: defaultLeaseDuration,
+		maxClaim:      defaultMaxClaim,
+		log:           log.NewHelper(logger),
+	}
+	if d := c.GetLeaseDuration(); d != nil && d.AsDuration() > 0 {
+		q.leaseDuration = d.AsDuration()
+	}
+	if c.GetMaxClaim() > 0 {
+		q.maxClaim = int(c.GetMaxClaim())
+	}
+	return q
+}
+
+// CheckLease 校验opUser持有任务的租约
+func (q *AuditQueue) CheckLease(ctx context.Context, taskType int32, taskID int64, opUser string) error {
+	leases, err := q.leases.GetLeases(ctx, taskType, []int64{taskID})
+	if err != nil {
+		return err
+	}
+	lease, ok := leases[taskID]
+	if !ok {
+		return v1.ErrorAuditLeaseInvalid("任务:%d没有被领取或者租约已过期，请先领取", taskID)
+	}
+	if lease.Owner != opUser {
+		return v1.ErrorAuditLeaseInvalid("任务:%d已被%s领取", taskID, lease.Owner)
+	}
+	return nil
+}
+
+// Release 审核完成后释放租约，失败时只记录日志，租约过期后会自动释放
+func (q *AuditQueue) Release(ctx context.Context, taskType int32, taskID int64, opUser string) {
+	if err := q.leases.ReleaseLease(ctx, taskType, taskID, opUser); err != nil {
+		q.log.WithContext(ctx).Errorf("ReleaseLease fail, taskType:%d taskID:%d err:%v", taskType, taskID, err)
+	}
+}
+
+// fillLeases 查询任务当前的租约
+func (q *AuditQueue) fillLeases(ctx context.Context, taskType int32, tasks []*AuditTask) error {
+	ids := make([]int64, 0, len(tasks))
+	for _, t := range tasks {
+		ids = append(ids, t.TaskID)
+	}
+	leases, err := q.leases.GetLeases(ctx, taskType, ids)
+	if err != nil {
+		return err
+	}
+	for _, t := range tasks {
+		t.Lease = leases[t.TaskID]
+	}
+	return nil
+}
+
+// claim 领取tasks中没有被领取的任务，opUser已经领取的任务也一起返回，最多返回count个
+func (q *AuditQueue) claim(ctx context.Context, taskType int32, tasks []*AuditTask, opUser string, count int) ([]*AuditTask, error) {
+	if err := q.fillLeases(ctx, taskType, tasks); err != nil {
+		return nil, err
+	}
+	ret := make([]*AuditTask, 0, count)
+	for _, t := range tasks {
+		if len(ret) >= count {
+			break
+		}
+		if t.Lease != nil {
+			if t.Lease.Owner == opUser {
+				ret = append(ret, t)
+			}
+			continue
+		}
+		ok, err := q.leases.AcquireLease(ctx, taskType, t.TaskID, opUser, q.leaseDuration)
+		if err != nil {
+			return nil, err
+		}
+		// 并发领取时被别人抢先
+		if !ok {
+			continue
+		}
+		t.Lease = &Lease{Owner: opUser, ExpireAt: time.Now().Add(q.leaseDuration)}
+		ret = append(ret, t)
+	}
+	return ret, nil
+}
+
+// ListPendingAudits 分页查询待审核的评价或申诉，按创建时间升序
+func (uc *ReviewUsecase) ListPendingAudits(ctx context.Context, taskType int32, page, size int) ([]*AuditTask, int64, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListPendingAudits taskType:%d page:%d size:%d", taskType, page, size)
+	if _, err := operatorFromContext(ctx); err != nil {
+		return nil, 0, err
+	}
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 || size > 50 {
+		size = 10
+	}
+	tasks, total, err := uc.listPendingTasks(ctx, taskType, (page-1)*size, size)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := uc.audit.fillLeases(ctx, taskType, tasks); err != nil {
+		return nil, 0, err
+	}
+	return tasks, total, nil
+}
+
+// ClaimAuditTask 领取一批待审核的评价或申诉，领取人是调用方的运营账号
+// 从最早的待审核记录开始领取，已经被别人领取的跳过
+func (uc *ReviewUsecase) ClaimAuditTask(ctx context.Context, taskType int32, count int) ([]*AuditTask, error) {
+	opUser, err := operatorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Debugf("[biz] ClaimAuditTask taskType:%d opUser:%s count:%d", taskType, opUser, count)
+	if count <= 0 || count > uc.audit.maxClaim {
+		return nil, v1.ErrorParamInvalid("领取的个数必须在1到%d之间", uc.audit.maxClaim)
+	}
+	ret := make([]*AuditTask, 0, count)
+	for offset := 0; offset < claimScanLimit && len(ret) < count; offset += claimScanSize {
+		tasks, _, err := uc.listPendingTasks(ctx, taskType, offset, claimScanSize)
+		if err != nil {
+			return nil, err
+		}
+		claimed, err := uc.audit.claim(ctx, taskType, tasks, opUser, count-len(ret))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, claimed...)
+		if len(tasks) < claimScanSize {
+			break
+		}
+	}
+	return ret, nil
+}
+
+// listPendingTasks 从数据库查询待审核的评价或申诉
+func (uc *ReviewUsecase) listPendingTasks(ctx context.Context, taskType int32, offset, limit int) ([]*AuditTask, int64, error) {
+	switch taskType {
+	case AuditTaskReview:
+		reviews, total, err := uc.repo.ListPendingReviews(ctx, offset, limit)
+		if err != nil {
+			return nil, 0, err
+		}
+		tasks := make([]*AuditTask, 0, len(reviews))
+		for _, r := range reviews {
+			tasks = append(tasks, &AuditTask{
+				TaskType: AuditTaskReview,
+				TaskID:   r.ReviewID,
+				ReviewID: r.ReviewID,
+				StoreID:  r.StoreID,
+				Content:  r.Content,
+				CreateAt: r.CreateAt,
+			})
+		}
+		return tasks, total, nil
+	case AuditTaskAppeal:
+		appeals, total, err := uc.repo.ListPendingAppeals(ctx, offset, limit)
+		if err != nil {
+			return nil, 0, err
+		}
+		tasks := make([]*AuditTask, 0, len(appeals))
+		for _, a := range appeals {
+			tasks = append(tasks, &AuditTask{
+				TaskType: AuditTaskAppeal,
+				TaskID:   a.AppealID,
+				ReviewID: a.ReviewID,
+				StoreID:  a.StoreID,
+				Content:  a.Content,
+				CreateAt: a.CreateAt,
+			})
+		}
+		return tasks, total, nil
+	}
+	return nil, 0, v1.ErrorParamInvalid("无效的任务类型:%d", taskType)
+}
@@ -0,0 +1,182 @@
+package biz
+
+import (
+	"context"
+	"reflect"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/data/model"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// memoryLeaseRepo 测试用的租约，raced中的任务查询时没有租约，领取时模拟被别人抢先
+type memoryLeaseRepo struct {
+	owners map[int64]string
+	raced  map[int64]bool
+}
+
+func (r *memoryLeaseRepo) AcquireLease(ctx context.Context, taskType int32, taskID int64, owner string, ttl time.Duration) (bool, error) {
+	if r.raced[taskID] {
+		return false, nil
+	}
+	if _, ok := r.owners[taskID]; ok {
+		return false, nil
+	}
+	r.owners[taskID] = owner
+	return true, nil
+}
+
+func (r *memoryLeaseRepo) GetLeases(ctx context.Context, taskType int32, taskIDs []int64) (map[int64]*Lease, error) {
+	ret := make(map[int64]*Lease)
+	for _, id := range taskIDs {
+		if owner, ok := r.owners[id]; ok {
+			ret[id] = &Lease{Owner: owner}
+		}
+	}
+	return ret, nil
+}
+
+func (r *memoryLeaseRepo) ReleaseLease(ctx context.Context, taskType int32, taskID int64, owner string) error {
+	if r.owners[taskID] == owner {
+		delete(r.owners, taskID)
+	}
+	return nil
+}
+
+func (r *memoryLeaseRepo) RenewLease(ctx context.Context, taskType int32, taskID int64, owner string, ttl time.Duration) (bool, error) {
+	return r.owners[taskID] == owner, nil
+}
+
+func TestAuditQueueClaim(t *testing.T) {
+	tests := []struct {
+		name   string
+		owners map[int64]string
+		raced  map[int64]bool
+		count  int
+		want   []int64
+	}{
+		{"全部没有被领取", nil, nil, 10, []int64{1, 2, 3, 4}},
+		{"最多领取count个", nil, nil, 2, []int64{1, 2}},
+		{"跳过别人领取的任务", map[int64]string{1: "bob", 3: "bob"}, nil, 10, []int64{2, 4}},
+		{"自己已经领取的任务一起返回", map[int64]string{2: "alice"}, nil, 2, []int64{1, 2}},
+		{"并发领取时被别人抢先", nil, map[int64]bool{1: true}, 2, []int64{2, 3}},
+		{"全部被别人领取", map[int64]string{1: "bob", 2: "bob", 3: "bob", 4: "bob"}, nil, 10, []int64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &memoryLeaseRepo{owners: map[int64]string{}, raced: tt.raced}
+			for id, owner := range tt.owners {
+				repo.owners[id] = owner
+			}
+			q := &AuditQueue{leases: repo, leaseDuration: time.Minute, log: log.NewHelper(log.DefaultLogger)}
+			tasks := []*AuditTask{{TaskID: 1}, {TaskID: 2}, {TaskID: 3}, {TaskID: 4}}
+			claimed, err := q.claim(context.Background(), AuditTaskReview, tasks, "alice", tt.count)
+			if err != nil {
+				t.Fatalf("claim() unexpected error: %v", err)
+			}
+			got := make([]int64, 0, len(claimed))
+			for _, task := range claimed {
+				got = append(got, task.TaskID)
+				if task.Lease == nil || task.Lease.Owner != "alice" {
+					t.Fatalf("claim() task:%d lease = %+v, want owner alice", task.TaskID, task.Lease)
+				}
+				if repo.owners[task.TaskID] != "alice" {
+					t.Fatalf("claim() task:%d repo owner = %q, want alice", task.TaskID, repo.owners[task.TaskID])
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("claim() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuditQueueCheckLease(t *testing.T) {
+	tests := []struct {
+		name    string
+		owners  map[int64]string
+		opUser  string
+		wantErr bool
+	}{
+		{"持有租约", map[int64]string{1: "alice"}, "alice", false},
+		{"没有被领取", map[int64]string{}, "alice", true},
+		{"被别人领取", map[int64]string{1: "bob"}, "alice", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := &AuditQueue{leases: &memoryLeaseRepo{owners: tt.owners}}
+			err := q.CheckLease(context.Background(), AuditTaskReview, 1, tt.opUser)
+			if tt.wantErr && !v1.IsAuditLeaseInvalid(err) {
+				t.Fatalf("CheckLease() = %v, want AUDIT_LEASE_INVALID", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("CheckLease() unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestAuditReviewOperator(t *testing.T) {
+	tests := []struct {
+		name    string
+		caller  *Caller
+		param   *AuditParam
+		wantErr func(error) bool
+	}{
+		{"领取人审核", &Caller{Role: RoleOperator, OpUser: "alice"}, &AuditParam{ReviewID: 1, Status: ReviewStatusApproved}, nil},
+		{"请求中的opUser不能代替调用方身份", &Caller{Role: RoleOperator, OpUser: "bob"}, &AuditParam{ReviewID: 1, OpUser: "alice", Status: ReviewStatusApproved}, v1.IsAuditLeaseInvalid},
+		{"没有调用方身份", nil, &AuditParam{ReviewID: 1, OpUser: "alice", Status: ReviewStatusApproved}, v1.IsOperatorForbidden},
+		{"商家不能审核", &Caller{Role: RoleMerchant, StoreID: 100}, &AuditParam{ReviewID: 1, OpUser: "alice", Status: ReviewStatusApproved}, v1.IsOperatorForbidden},
+		{"运营账号为空", &Caller{Role: RoleOperator}, &AuditParam{ReviewID: 1, OpUser: "alice", Status: ReviewStatusApproved}, v1.IsOperatorForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &memoryReviewRepo{reviews: map[int64]*model.ReviewInfo{1: {ReviewID: 1, Status: ReviewStatusPending}}}
+			leases := &memoryLeaseRepo{owners: map[int64]string{1: "alice"}}
+			uc := &ReviewUsecase{
+				repo:  repo,
+				audit: &AuditQueue{leases: leases, log: log.NewHelper(log.DefaultLogger)},
+				log:   log.NewHelper(log.DefaultLogger),
+			}
+			ctx := context.Background()
+			if tt.caller != nil {
+				ctx = NewCallerContext(ctx, tt.caller)
+			}
+			err := uc.AuditReview(ctx, tt.param)
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Fatalf("AuditReview() error = %v", err)
+				}
+				if repo.lastAudit != nil || leases.owners[1] != "alice" {
+					t.Fatalf("AuditReview() should not audit or release the lease")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AuditReview() unexpected error: %v", err)
+			}
+			if repo.lastAudit.OpUser != "alice" {
+				t.Fatalf("AuditReview() opUser = %q, want alice", repo.lastAudit.OpUser)
+			}
+			if _, ok := leases.owners[1]; ok {
+				t.Fatalf("AuditReview() lease should be released")
+			}
+		})
+	}
+}
+
+func TestClaimAuditTaskOperator(t *testing.T) {
+	uc := &ReviewUsecase{
+		audit: &AuditQueue{leases: &memoryLeaseRepo{owners: map[int64]string{}}, maxClaim: 10},
+		log:   log.NewHelper(log.DefaultLogger),
+	}
+	ctx := NewCallerContext(context.Background(), &Caller{Role: RoleUser, UserID: 1})
+	if _, err := uc.ClaimAuditTask(ctx, AuditTaskReview, 1); !v1.IsOperatorForbidden(err) {
+		t.Fatalf("ClaimAuditTask() error = %v, want OPERATOR_FORBIDDEN", err)
+	}
+	if _, _, err := uc.ListPendingAudits(ctx, AuditTaskReview, 1, 10); !v1.IsOperatorForbidden(err) {
+		t.Fatalf("ListPendingAudits() error = %v, want OPERATOR_FORBIDDEN", err)
+	}
+}
@@ -0,0 +1,389 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/pkg/snowflake"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 自动回复规则匹配的评价内容
+const (
+	ContentTypeAny     int32 = 0 // 不限
+	ContentTypeEmpty   int32 = 1 // 没有内容（默认好评）
+	ContentTypeContent int32 = 2 // 有内容
+)
+
+// 回复模板中的占位符
+const (
+	PlaceholderNickname = "{nickname}" // 用户昵称
+	PlaceholderProduct  = "{product}"  // 商品名称
+)
+
+// defaultNickname 匿名评价或者查询不到用户时对用户的称呼
+const defaultNickname = "亲"
+
+// maxTemplateLen 模板内容的最大长度，和回复内容的字段长度一致
+const maxTemplateLen = 512
+
+// 没有配置时的默认值
+const (
+	defaultAutoReplyBatchSize  = 100
+	defaultAutoReplyScanWindow = 7 * 24 * time.Hour
+)
+
+// AutoReplyRepo 回复模板和自动回复规则
+type AutoReplyRepo interface {
+	SaveTemplate(ctx context.Context, tpl *model.ReviewReplyTemplate) error
+	GetTemplate(ctx context.Context, templateID int64) (*model.ReviewReplyTemplate, error)
+	UpdateTemplate(ctx context.Context, tpl *model.ReviewReplyTemplate, param *ReplyTemplateParam) error
+	DeleteTemplate(ctx context.Context, tpl *model.ReviewReplyTemplate) error
+	ListTemplateByStoreID(ctx context.Context, storeID int64) ([]*model.ReviewReplyTemplate, error)
+	SaveRule(ctx context.Context, rule *model.ReviewAutoReplyRule) error
+	GetRule(ctx context.Context, ruleID int64) (*model.ReviewAutoReplyRule, error)
+	UpdateRule(ctx context.Context, rule *model.ReviewAutoReplyRule, param *AutoReplyRuleParam) error
+	DeleteRule(ctx context.Context, rule *model.ReviewAutoReplyRule) error
+	// ListRuleByStoreID 查询店铺的规则，按优先级排序
+	ListRuleByStoreID(ctx context.Context, storeID int64) ([]*model.ReviewAutoReplyRule, error)
+	CountRuleByTemplateID(ctx context.Context, templateID int64) (int64, error)
+	// ListEnabledRules 查询所有启用的规则，按店铺和优先级排序
+	ListEnabledRules(ctx context.Context) ([]*model.ReviewAutoReplyRule, error)
+	// ListUnrepliedReviews 按ID升序查询店铺在[from, to)之间发布的、审核通过并且从来没有回复过的评价
+	// 商家撤回过回复的评价不算，避免自动回复替商家重新回复
+	ListUnrepliedReviews(ctx context.Context, storeID int64, from, to time.Time, afterID int64, limit int) ([]*model.ReviewInfo, error)
+}
+
+// AutoReplyUsecase 商家回复模板和自动回复
+type AutoReplyUsecase struct {
+	repo       AutoReplyRepo
+	reviews    *ReviewUsecase
+	users      UserClient
+	batchSize  int
+	scanWindow time.Duration
+	log        *log.Helper
+}
+
+func NewAutoReplyUsecase(repo AutoReplyRepo, reviews *ReviewUsecase, users UserClient, cfg *conf.Review, logger log.Logger) *AutoReplyUsecase {
+	c := cfg.GetAutoReply()
+	uc := &AutoReplyUsecase{
+		repo:       repo,
+		reviews:    reviews,
+		users:      users,
+		batchSize:  defaultAutoReplyBatchSize,
+		scanWindow: defaultAutoReplyScanWindow,
+		log:        log.NewHelper(logger),
+	}
+	if c.GetBatchSize() > 0 {
+		uc.batchSize = int(c.GetBatchSize())
+	}
+	if d := c.GetScanWindow(); d != nil && d.AsDuration() > 0 {
+		uc.scanWindow = d.AsDuration()
+	}
+	return uc
+}
+
+// CreateTemplate 创建回复模板
+func (uc *AutoReplyUsecase) CreateTemplate(ctx context.Context, param *ReplyTemplateParam) (*model.ReviewReplyTemplate, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] CreateTemplate param:%v", param)
+	if err := checkTemplate(param); err != nil {
+		return nil, err
+	}
+	tpl := &model.ReviewReplyTemplate{
+		TemplateID: snowflake.GenID(),
+		StoreID:    param.StoreID,
+		Name:       param.Name,
+		Content:    param.Content,
+		CreateBy:   strconv.FormatInt(param.StoreID, 10),
+	}
+	if err := uc.repo.SaveTemplate(ctx, tpl); err != nil {
+		return nil, err
+	}
+	return tpl, nil
+}
+
+// UpdateTemplate 修改回复模板
+func (uc *AutoReplyUsecase) UpdateTemplate(ctx context.Context, param *ReplyTemplateParam) error {
+	uc.log.WithContext(ctx).Debugf("[biz] UpdateTemplate param:%v", param)
+	if err := checkTemplate(param); err != nil {
+		return err
+	}
+	tpl, err := uc.getStoreTemplate(ctx, param.TemplateID, param.StoreID)
+	if err != nil {
+		return err
+	}
+	return uc.repo.UpdateTemplate(ctx, tpl, param)
+}
+
+// DeleteTemplate 删除回复模板
+func (uc *AutoReplyUsecase) DeleteTemplate(ctx context.Context, templateID, storeID int64) error {
+	uc.log.WithContext(ctx).Debugf("[biz] DeleteTemplate templateID:%d storeID:%d", templateID, storeID)
+	tpl, err := uc.getStoreTemplate(ctx, templateID, storeID)
+	if err != nil {
+		return err
+	}
+	n, err := uc.repo.CountRuleByTemplateID(ctx, templateID)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return v1.ErrorParamInvalid("模板:%d正在被%d条自动回复规则使用，不能删除", templateID, n)
+	}
+	return uc.repo.DeleteTemplate(ctx, tpl)
+}
+
+// ListTemplates 查询店铺的回复模板
+func (uc *AutoReplyUsecase) ListTemplates(ctx context.Context, storeID int64) ([]*model.ReviewReplyTemplate, error) {
+	return uc.repo.ListTemplateByStoreID(ctx, storeID)
+}
+
+// getStoreTemplate 查询模板并校验模板属于该店铺
+func (uc *AutoReplyUsecase) getStoreTemplate(ctx context.Context, templateID, storeID int64) (*model.ReviewReplyTemplate, error) {
+	tpl, err := uc.repo.GetTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	// 水平越权校验：只能操作自己店铺的模板
+	if tpl.StoreID != storeID {
+		return nil, v1.ErrorStoreForbidden("店铺:%d无权操作模板:%d", storeID, templateID)
+	}
+	return tpl, nil
+}
+
+func checkTemplate(param *ReplyTemplateParam) error {
+	if param.Name == "" {
+		return v1.ErrorParamInvalid("模板名称不能为空")
+	}
+	if param.Content == "" {
+		return v1.ErrorParamInvalid("模板内容不能为空")
+	}
+	if utf8.RuneCountInString(param.Content) > maxTemplateLen {
+		return v1.ErrorParamInvalid("模板内容不能超过%d个字", maxTemplateLen)
+	}
+	return nil
+}
+
+// CreateRule 创建自动回复规则
+func (uc *AutoReplyUsecase) CreateRule(ctx context.Context, param *AutoReplyRuleParam) (*model.ReviewAutoReplyRule, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] CreateRule param:%v", param)
+	if err := uc.checkRule(ctx, param); err != nil {
+		return nil, err
+	}
+	rule := &model.ReviewAutoReplyRule{
+		RuleID:      snowflake.GenID(),
+		StoreID:     param.StoreID,
+		TemplateID:  param.TemplateID,
+		MinScore:    param.MinScore,
+		MaxScore:    param.MaxScore,
+		ContentType: param.ContentType,
+		Delay:       param.Delay,
+		Priority:    param.Priority,
+		Enable:      boolToInt32(param.Enable),
+		CreateBy:    strconv.FormatInt(param.StoreID, 10),
+	}
+	if err := uc.repo.SaveRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// UpdateRule 修改自动回复规则
+func (uc *AutoReplyUsecase) UpdateRule(ctx context.Context, param *AutoReplyRuleParam) error {
+	uc.log.WithContext(ctx).Debugf("[biz] UpdateRule param:%v", param)
+	if err := uc.checkRule(ctx, param); err != nil {
+		return err
+	}
+	rule, err := uc.getStoreRule(ctx, param.RuleID, param.StoreID)
+	if err != nil {
+		return err
+	}
+	return uc.repo.UpdateRule(ctx, rule, param)
+}
+
+// DeleteRule 删除自动回复规则
+func (uc *AutoReplyUsecase) DeleteRule(ctx context.Context, ruleID, storeID int64) error {
+	uc.log.WithContext(ctx).Debugf("[biz] DeleteRule ruleID:%d storeID:%d", ruleID, storeID)
+	rule, err := uc.getStoreRule(ctx, ruleID, storeID)
+	if err != nil {
+		return err
+	}
+	return uc.repo.DeleteRule(ctx, rule)
+}
+
+// ListRules 查询店铺的自动回复规则
+func (uc *AutoReplyUsecase) ListRules(ctx context.Context, storeID int64) ([]*model.ReviewAutoReplyRule, error) {
+	return uc.repo.ListRuleByStoreID(ctx, storeID)
+}
+
+// getStoreRule 查询规则并校验规则属于该店铺
+func (uc *AutoReplyUsecase) getStoreRule(ctx context.Context, ruleID, storeID int64) (*model.ReviewAutoReplyRule, error) {
+	rule, err := uc.repo.GetRule(ctx, ruleID)
+	if err != nil {
+		return nil, err
+	}
+	if rule.StoreID != storeID {
+		return nil, v1.ErrorStoreForbidden("店铺:%d无权操作规则:%d", storeID, ruleID)
+	}
+	return rule, nil
+}
+
+func (uc *AutoReplyUsecase) checkRule(ctx context.Context, param *AutoReplyRuleParam) error {
+	if param.MinScore < 1 || param.MaxScore > 5 || param.MinScore > param.MaxScore {
+		return v1.ErrorParamInvalid("评分范围必须在1到5之间")
+	}
+	if param.ContentType < ContentTypeAny || param.ContentType > ContentTypeContent {
+		return v1.ErrorParamInvalid("无效的评价内容类型:%d", param.ContentType)
+	}
+	if param.Delay < 0 {
+		return v1.ErrorParamInvalid("自动回复的延迟不能小于0")
+	}
+	// 只能使用自己店铺的模板
+	_, err := uc.getStoreTemplate(ctx, param.TemplateID, param.StoreID)
+	return err
+}
+
+// RunAutoReply 执行一轮自动回复，返回自动回复的评价数
+// 每个店铺的规则按优先级依次匹配，一条评价只使用第一条匹配的规则
+// 单条评价或者单个店铺的业务错误只跳过对应的评价，数据库等基础设施出错时才中断这一轮
+func (uc *AutoReplyUsecase) RunAutoReply(ctx context.Context) (int, error) {
+	rules, err := uc.repo.ListEnabledRules(ctx)
+	if err != nil {
+		return 0, err
+	}
+	// 规则已经按店铺排好序，同一个店铺的规则是连续的
+	var n int
+	for start := 0; start < len(rules); {
+		end := start
+		for end < len(rules) && rules[end].StoreID == rules[start].StoreID {
+			end++
+		}
+		cnt, err := uc.autoReplyStore(ctx, rules[start].StoreID, rules[start:end])
+		n += cnt
+		if err != nil {
+			return n, err
+		}
+		start = end
+	}
+	return n, nil
+}
+
+// autoReplyStore 对一个店铺的未回复评价执行自动回复
+func (uc *AutoReplyUsecase) autoReplyStore(ctx context.Context, storeID int64, rules []*model.ReviewAutoReplyRule) (int, error) {
+	now := time.Now()
+	// 延迟最短的规则决定了最晚可以处理到哪条评价
+	minDelay := rules[0].Delay
+	for _, rule := range rules {
+		if rule.Delay < minDelay {
+			minDelay = rule.Delay
+		}
+	}
+	from := now.Add(-uc.scanWindow)
+	to := now.Add(-time.Duration(minDelay) * time.Second)
+	templates := make(map[int64]*model.ReviewReplyTemplate)
+	var n int
+	var afterID int64
+	for {
+		reviews, err := uc.repo.ListUnrepliedReviews(ctx, storeID, from, to, afterID, uc.batchSize)
+		if err != nil {
+			return n, err
+		}
+		for _, review := range reviews {
+			rule := matchRule(rules, review, now)
+			if rule == nil {
+				continue
+			}
+			tpl, ok := templates[rule.TemplateID]
+			if !ok {
+				tpl, err = uc.repo.GetTemplate(ctx, rule.TemplateID)
+				if isInfraError(err) {
+					return n, err
+				}
+				// 模板被删除等情况，这一轮跳过使用该模板的规则匹配到的评价
+				if err != nil {
+					uc.log.WithContext(ctx).Warnf("auto reply template unavailable, storeID:%d ruleID:%d templateID:%d err:%v", storeID, rule.RuleID, rule.TemplateID, err)
+				}
+				templates[rule.TemplateID] = tpl
+			}
+			if tpl == nil {
+				continue
+			}
+			_, err := uc.reviews.CreateReply(ctx, &ReplyParam{
+				ReviewID: review.ReviewID,
+				StoreID:  storeID,
+				Content:  uc.render(ctx, tpl.Content, review),
+				Auto:     true,
+			})
+			if isInfraError(err) {
+				return n, err
+			}
+			// 商家刚好手动回复（或者回复后又撤回）了、渲染后的内容包含敏感词、并发修改冲突等，跳过这条评价，下一轮再处理
+			if err != nil {
+				uc.log.WithContext(ctx).Warnf("auto reply skipped, reviewID:%d ruleID:%d err:%v", review.ReviewID, rule.RuleID, err)
+				continue
+			}
+			n++
+		}
+		if len(reviews) < uc.batchSize {
+			return n, nil
+		}
+		afterID = reviews[len(reviews)-1].ID
+	}
+}
+
+// isInfraError 判断是否是数据库、缓存、下游服务等基础设施的错误
+// v1中定义的业务错误状态码都小于500，未知错误按基础设施错误处理
+func isInfraError(err error) bool {
+	return err != nil && errors.Code(err) >= 500
+}
+
+// matchRule 返回第一条匹配评价的规则，rules已经按优先级排序
+func matchRule(rules []*model.ReviewAutoReplyRule, review *model.ReviewInfo, now time.Time) *model.ReviewAutoReplyRule {
+	empty := review.IsDefault == 1 || strings.TrimSpace(review.Content) == ""
+	for _, rule := range rules {
+		if review.Score < rule.MinScore || review.Score > rule.MaxScore {
+			continue
+		}
+		if rule.ContentType == ContentTypeEmpty && !empty || rule.ContentType == ContentTypeContent && empty {
+			continue
+		}
+		if now.Sub(review.CreateAt) < time.Duration(rule.Delay)*time.Second {
+			continue
+		}
+		return rule
+	}
+	return nil
+}
+
+// render 替换模板中的占位符
+func (uc *AutoReplyUsecase) render(ctx context.Context, content string, review *model.ReviewInfo) string {
+	nickname := defaultNickname
+	if review.Anonymous != 1 && strings.Contains(content, PlaceholderNickname) {
+		user, err := uc.users.GetUser(ctx, review.UserID)
+		if err != nil {
+			uc.log.WithContext(ctx).Warnf("GetUser fail, userID:%d err:%v", review.UserID, err)
+		} else if user.Nickname != "" {
+			nickname = user.Nickname
+		}
+	}
+	var product string
+	var snapshot GoodsSnapshot
+	if err := json.Unmarshal([]byte(review.GoodsSnapshoot), &snapshot); err == nil {
+		product = snapshot.Title
+	}
+	return strings.NewReplacer(PlaceholderNickname, nickname, PlaceholderProduct, product).Replace(content)
+}
+
+func boolToInt32(b bool) int32 {
+	if b {
+		return 1
+	}
+	return 0
+}
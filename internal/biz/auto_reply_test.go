@@ -0,0 +1,139 @@
+package biz
+
+import (
+	"context"
+	"reflect"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/data/model"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// memoryAutoReplyRepo 测试用的自动回复repo，只实现了自动回复任务用到的方法
+type memoryAutoReplyRepo struct {
+	AutoReplyRepo
+	rules       []*model.ReviewAutoReplyRule
+	templates   map[int64]*model.ReviewReplyTemplate
+	templateErr error // templates中没有的模板返回的错误，为nil时返回TEMPLATE_NOT_FOUND
+	reviews     map[int64][]*model.ReviewInfo
+}
+
+func (r *memoryAutoReplyRepo) ListEnabledRules(ctx context.Context) ([]*model.ReviewAutoReplyRule, error) {
+	return r.rules, nil
+}
+
+func (r *memoryAutoReplyRepo) GetTemplate(ctx context.Context, templateID int64) (*model.ReviewReplyTemplate, error) {
+	if tpl, ok := r.templates[templateID]; ok {
+		return tpl, nil
+	}
+	if r.templateErr != nil {
+		return nil, r.templateErr
+	}
+	return nil, v1.ErrorTemplateNotFound("模板:%d不存在", templateID)
+}
+
+func (r *memoryAutoReplyRepo) ListUnrepliedReviews(ctx context.Context, storeID int64, from, to time.Time, afterID int64, limit int) ([]*model.ReviewInfo, error) {
+	var ret []*model.ReviewInfo
+	for _, review := range r.reviews[storeID] {
+		if review.ID > afterID && len(ret) < limit {
+			ret = append(ret, review)
+		}
+	}
+	return ret, nil
+}
+
+func TestMatchRule(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.Local)
+	// 规则已经按优先级排序
+	rules := []*model.ReviewAutoReplyRule{
+		{RuleID: 1, MinScore: 1, MaxScore: 2, ContentType: ContentTypeContent, Delay: 0},
+		{RuleID: 2, MinScore: 5, MaxScore: 5, ContentType: ContentTypeEmpty, Delay: 3600},
+		{RuleID: 3, MinScore: 4, MaxScore: 5, ContentType: ContentTypeAny, Delay: 600},
+	}
+	tests := []struct {
+		name   string
+		review *model.ReviewInfo
+		want   int64 // 匹配的规则ID，0表示没有匹配
+	}{
+		{"差评有内容", &model.ReviewInfo{Score: 1, Content: "太差了", CreateAt: now}, 1},
+		{"差评没有内容", &model.ReviewInfo{Score: 2, Content: "  ", CreateAt: now}, 0},
+		{"中评没有规则", &model.ReviewInfo{Score: 3, Content: "一般", CreateAt: now.Add(-24 * time.Hour)}, 0},
+		{"默认好评优先匹配高优先级规则", &model.ReviewInfo{Score: 5, IsDefault: 1, CreateAt: now.Add(-2 * time.Hour)}, 2},
+		{"默认好评延迟没到匹配下一条规则", &model.ReviewInfo{Score: 5, IsDefault: 1, CreateAt: now.Add(-30 * time.Minute)}, 3},
+		{"有内容的好评", &model.ReviewInfo{Score: 5, Content: "很好", CreateAt: now.Add(-2 * time.Hour)}, 3},
+		{"延迟刚好到", &model.ReviewInfo{Score: 4, Content: "不错", CreateAt: now.Add(-10 * time.Minute)}, 3},
+		{"延迟都没到", &model.ReviewInfo{Score: 4, Content: "不错", CreateAt: now.Add(-5 * time.Minute)}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got int64
+			if rule := matchRule(rules, tt.review, now); rule != nil {
+				got = rule.RuleID
+			}
+			if got != tt.want {
+				t.Fatalf("matchRule() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunAutoReply(t *testing.T) {
+	createAt := time.Now().Add(-time.Hour)
+	newReview := func(id, storeID int64) *model.ReviewInfo {
+		return &model.ReviewInfo{ID: id, ReviewID: id, StoreID: storeID, Score: 5, Content: "很好", CreateAt: createAt}
+	}
+	// 店铺1的模板已删除，店铺2回复评价21时并发冲突，店铺3正常
+	rules := []*model.ReviewAutoReplyRule{
+		{RuleID: 1, StoreID: 1, TemplateID: 10, MinScore: 1, MaxScore: 5},
+		{RuleID: 2, StoreID: 2, TemplateID: 20, MinScore: 1, MaxScore: 5},
+		{RuleID: 3, StoreID: 3, TemplateID: 30, MinScore: 1, MaxScore: 5},
+	}
+	reviews := map[int64][]*model.ReviewInfo{
+		1: {newReview(11, 1)},
+		2: {newReview(21, 2), newReview(22, 2)},
+		3: {newReview(31, 3)},
+	}
+	templates := map[int64]*model.ReviewReplyTemplate{
+		20: {TemplateID: 20, Content: "谢谢"},
+		30: {TemplateID: 30, Content: "谢谢"},
+	}
+	tests := []struct {
+		name        string
+		templateErr error
+		wantN       int
+		wantReplied []int64
+		wantErr     bool
+	}{
+		{"业务错误只跳过对应的店铺和评价", nil, 2, []int64{22, 31}, false},
+		{"数据库出错时中断", v1.ErrorDbFailed("数据库操作失败"), 0, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reviewRepo := &memoryReviewRepo{replyErr: map[int64]error{21: v1.ErrorVersionConflict("评价:%d已被修改", 21)}}
+			logger := log.NewHelper(log.DefaultLogger)
+			uc := &AutoReplyUsecase{
+				repo:       &memoryAutoReplyRepo{rules: rules, templates: templates, templateErr: tt.templateErr, reviews: reviews},
+				reviews:    &ReviewUsecase{repo: reviewRepo, moderator: &Moderator{}, log: logger},
+				batchSize:  defaultAutoReplyBatchSize,
+				scanWindow: defaultAutoReplyScanWindow,
+				log:        logger,
+			}
+			n, err := uc.RunAutoReply(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RunAutoReply() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if n != tt.wantN {
+				t.Errorf("RunAutoReply() = %d, want %d", n, tt.wantN)
+			}
+			var replied []int64
+			for _, reply := range reviewRepo.replies {
+				replied = append(replied, reply.ReviewID)
+			}
+			if !reflect.DeepEqual(replied, tt.wantReplied) {
+				t.Errorf("replied = %v, want %v", replied, tt.wantReplied)
+			}
+		})
+	}
+}
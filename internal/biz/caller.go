@@ -0,0 +1,62 @@
+package biz
+
+import (
+	"context"
+	v1 "review-service/api/review/v1"
+)
+
+// 调用方的身份
+const (
+	RoleUser     int32 = 1 // C端用户，没有登录时UserID为0
+	RoleMerchant int32 = 2 // 商家
+	RoleOperator int32 = 3 // 运营
+)
+
+// Caller 调用方身份，由网关鉴权之后通过请求头传入，server层的中间件解析后放到ctx中
+// 需要区分调用方的查询从ctx中获取身份，不能信任请求参数中的店铺ID和运营账号
+type Caller struct {
+	Role    int32
+	UserID  int64
+	StoreID int64
+	OpUser  string
+}
+
+type callerKey struct{}
+
+// NewCallerContext 把调用方身份放到ctx中
+func NewCallerContext(ctx context.Context, caller *Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// CallerFromContext 从ctx中获取调用方身份，没有身份信息时按未登录的C端用户处理
+func CallerFromContext(ctx context.Context) *Caller {
+	if caller, ok := ctx.Value(callerKey{}).(*Caller); ok && caller != nil {
+		return caller
+	}
+	return &Caller{Role: RoleUser}
+}
+
+// IsOperator 是否为运营
+func (c *Caller) IsOperator() bool {
+	return c.Role == RoleOperator && c.OpUser != ""
+}
+
+// IsMerchantOf 是否为该店铺的商家
+func (c *Caller) IsMerchantOf(storeID int64) bool {
+	return c.Role == RoleMerchant && c.StoreID > 0 && c.StoreID == storeID
+}
+
+// IsUser 是否为该用户本人
+func (c *Caller) IsUser(userID int64) bool {
+	return c.Role == RoleUser && c.UserID > 0 && c.UserID == userID
+}
+
+// operatorFromContext 获取调用方的运营账号，调用方不是运营时返回错误
+// 运营接口的操作人只能从这里获取，请求参数中的opUser可以被任意填写
+func operatorFromContext(ctx context.Context) (string, error) {
+	caller := CallerFromContext(ctx)
+	if !caller.IsOperator() {
+		return "", v1.ErrorOperatorForbidden("只有运营可以操作")
+	}
+	return caller.OpUser, nil
+}
@@ -0,0 +1,176 @@
+package biz
+
+import (
+	"context"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/pkg/snowflake"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 默认好评任务没有配置时的默认值
+const (
+	defaultReviewWindow     = 15 * 24 * time.Hour
+	defaultReviewScanWindow = 3 * 24 * time.Hour
+	defaultReviewBatchSize  = 100
+	defaultReviewContent    = "此用户没有填写评价。"
+)
+
+// CompletedOrder 已完成的订单
+type CompletedOrder struct {
+	OrderID    int64
+	UserID     int64
+	StoreID    int64
+	Items      []*OrderItem
+	CompleteAt time.Time
+}
+
+// OrderItem 订单中的商品
+type OrderItem struct {
+	SkuID int64
+	SpuID int64
+}
+
+// OrderSource 已完成订单的数据来源
+type OrderSource interface {
+	// ListCompletedOrders 查询完成时间在[from, to)之间的订单，按订单ID升序返回afterOrderID之后的limit个
+	ListCompletedOrders(ctx context.Context, from, to time.Time, afterOrderID int64, limit int) ([]*CompletedOrder, error)
+}
+
+// DefaultReviewUsecase 默认好评
+// 订单完成后超过评价时间还没有评价的商品，由系统自动给出五星好评
+type DefaultReviewUsecase struct {
+	repo       ReviewRepo
+	orders     OrderSource
+	window     time.Duration // 订单完成后超过这个时间还没有评价就自动好评
+	scanWindow time.Duration // 每次只扫描刚超过评价时间的这段时间内完成的订单
+	batchSize  int
+	content    string
+	log        *log.Helper
+}
+
+func NewDefaultReviewUsecase(repo ReviewRepo, orders OrderSource, cfg *conf.Review, logger log.Logger) *DefaultReviewUsecase {
+	uc := &DefaultReviewUsecase{
+		repo:       repo,
+		orders:     orders,
+		window:     defaultReviewWindow,
+		scanWindow: defaultReviewScanWindow,
+		batchSize:  defaultReviewBatchSize,
+		content:    defaultReviewContent,
+		log:        log.NewHelper(logger),
+	}
+	c := cfg.GetDefaultReview()
+	if d := c.GetReviewWindow(); d != nil && d.AsDuration() > 0 {
+		uc.window = d.AsDuration()
+	}
+	if d := c.GetScanWindow(); d != nil && d.AsDuration() > 0 {
+		uc.scanWindow = d.AsDuration()
+	}
+	if c.GetBatchSize() > 0 {
+		uc.batchSize = int(c.GetBatchSize())
+	}
+	if c.GetContent() != "" {
+		uc.content = c.GetContent()
+	}
+	return uc
+}
+
+// CreateDefaultReviews 给超过评价时间还没有评价的订单商品创建默认好评，返回创建的评价数
+// 只扫描完成时间在[now-window-scanWindow, now-window)之间的订单，扫描间隔远小于scanWindow，
+// 所以每个订单在超过评价时间后会被扫描多次，已经评价过的商品会被跳过，重复执行是幂等的
+func (uc *DefaultReviewUsecase) CreateDefaultReviews(ctx context.Context) (int, error) {
+	to := time.Now().Add(-uc.window)
+	from := to.Add(-uc.scanWindow)
+	var (
+		created int
+		after   int64
+	)
+	for {
+		orders, err := uc.orders.ListCompletedOrders(ctx, from, to, after, uc.batchSize)
+		if err != nil {
+			return created, err
+		}
+		if len(orders) == 0 {
+			return created, nil
+		}
+		n, err := uc.createBatch(ctx, orders)
+		created += n
+		if err != nil {
+			return created, err
+		}
+		after = orders[len(orders)-1].OrderID
+	}
+}
+
+// createBatch 处理一批订单，一次查询出这批订单已有的评价
+func (uc *DefaultReviewUsecase) createBatch(ctx context.Context, orders []*CompletedOrder) (int, error) {
+	orderIDs := make([]int64, 0, len(orders))
+	for _, order := range orders {
+		orderIDs = append(orderIDs, order.OrderID)
+	}
+	// 已删除的评价也要查出来，删除过评价的商品不会再生成默认好评
+	existing, err := uc.repo.ListReviewByOrderIDsWithDeleted(ctx, orderIDs)
+	if err != nil {
+		return 0, err
+	}
+	reviewed := make(map[[2]int64]struct{}, len(existing))
+	for _, review := range existing {
+		reviewed[[2]int64{review.OrderID, review.SkuID}] = struct{}{}
+	}
+	var created int
+	for _, order := range orders {
+		reviews := make([]*model.ReviewInfo, 0, len(order.Items))
+		for _, item := range order.Items {
+			if _, ok := reviewed[[2]int64{order.OrderID, item.SkuID}]; ok {
+				continue
+			}
+			review, err := uc.defaultReview(order, item)
+			if err != nil {
+				return created, err
+			}
+			reviews = append(reviews, review)
+		}
+		if len(reviews) == 0 {
+			continue
+		}
+		// 每个订单一个事务
+		if err := uc.repo.SaveReviews(ctx, reviews); err != nil {
+			// 用户刚好在这时评价了，跳过这个订单，下次扫描时再处理剩下的商品
+			if v1.IsOrderReviewed(err) {
+				uc.log.WithContext(ctx).Infof("CreateDefaultReviews skip order:%d, err:%v", order.OrderID, err)
+				continue
+			}
+			return created, err
+		}
+		created += len(reviews)
+	}
+	return created, nil
+}
+
+// defaultReview 构造一条默认好评
+// 默认好评是系统生成的，不需要运营审核，由系统从初始状态直接审核通过
+func (uc *DefaultReviewUsecase) defaultReview(order *CompletedOrder, item *OrderItem) (*model.ReviewInfo, error) {
+	status := ReviewStatusMachine.Initial()
+	if err := ReviewStatusMachine.Transit(status, ReviewStatusApproved); err != nil {
+		return nil, err
+	}
+	return &model.ReviewInfo{
+		ReviewID:     snowflake.GenID(),
+		CreateBy:     "system",
+		UpdateBy:     "system",
+		OrderID:      order.OrderID,
+		UserID:       order.UserID,
+		StoreID:      order.StoreID,
+		SkuID:        item.SkuID,
+		SpuID:        item.SpuID,
+		Score:        5,
+		ServiceScore: 5,
+		ExpressScore: 5,
+		Content:      uc.content,
+		Status:       ReviewStatusApproved,
+		IsDefault:    1,
+	}, nil
+}
@@ -0,0 +1,98 @@
+package biz
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"review-service/internal/data/model"
+	"review-service/pkg/snowflake"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestMain(m *testing.M) {
+	// 生成评价ID需要先初始化雪花算法
+	if err := snowflake.Init("2024-01-01", 1); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+// memoryOrderSource 测试用的已完成订单，按订单ID升序
+type memoryOrderSource struct {
+	orders []*CompletedOrder
+}
+
+func (s *memoryOrderSource) ListCompletedOrders(ctx context.Context, from, to time.Time, afterOrderID int64, limit int) ([]*CompletedOrder, error) {
+	var ret []*CompletedOrder
+	for _, order := range s.orders {
+		if order.OrderID > afterOrderID && !order.CompleteAt.Before(from) && order.CompleteAt.Before(to) && len(ret) < limit {
+			ret = append(ret, order)
+		}
+	}
+	return ret, nil
+}
+
+func TestCreateDefaultReviews(t *testing.T) {
+	now := time.Now()
+	order := func(orderID int64, completeAt time.Time, skuIDs ...int64) *CompletedOrder {
+		o := &CompletedOrder{OrderID: orderID, UserID: 200, StoreID: 100, CompleteAt: completeAt}
+		for _, id := range skuIDs {
+			o.Items = append(o.Items, &OrderItem{SkuID: id, SpuID: 1})
+		}
+		return o
+	}
+	due := now.Add(-16 * 24 * time.Hour) // 超过15天的评价时间，在3天的扫描窗口内
+	tests := []struct {
+		name    string
+		orders  []*CompletedOrder
+		reviews map[int64]*model.ReviewInfo
+		deleted map[int64]*model.ReviewInfo
+		want    [][2]int64 // 生成默认好评的订单和商品
+	}{
+		{"超过评价时间没有评价", []*CompletedOrder{order(1, due, 10, 11)}, nil, nil, [][2]int64{{1, 10}, {1, 11}}},
+		{"已经评价的商品跳过", []*CompletedOrder{order(1, due, 10, 11)}, map[int64]*model.ReviewInfo{1: {OrderID: 1, SkuID: 10}}, nil, [][2]int64{{1, 11}}},
+		{"删除过评价的商品跳过", []*CompletedOrder{order(1, due, 10, 11)}, nil, map[int64]*model.ReviewInfo{1: {OrderID: 1, SkuID: 11}}, [][2]int64{{1, 10}}},
+		{"全部已评价", []*CompletedOrder{order(1, due, 10)}, map[int64]*model.ReviewInfo{1: {OrderID: 1, SkuID: 10}}, nil, nil},
+		{"还在评价时间内", []*CompletedOrder{order(1, now.Add(-24*time.Hour), 10), order(2, due, 10)}, nil, nil, [][2]int64{{2, 10}}},
+		{"早于扫描窗口的订单不再扫描", []*CompletedOrder{order(1, now.Add(-30*24*time.Hour), 10), order(2, due, 10)}, nil, nil, [][2]int64{{2, 10}}},
+		{"分多批处理", []*CompletedOrder{order(1, due, 10), order(2, due, 10), order(3, due, 10)}, nil, nil, [][2]int64{{1, 10}, {2, 10}, {3, 10}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &memoryReviewRepo{reviews: tt.reviews, deleted: tt.deleted}
+			uc := &DefaultReviewUsecase{
+				repo:       repo,
+				orders:     &memoryOrderSource{orders: tt.orders},
+				window:     15 * 24 * time.Hour,
+				scanWindow: 3 * 24 * time.Hour,
+				batchSize:  2,
+				content:    defaultReviewContent,
+				log:        log.NewHelper(log.DefaultLogger),
+			}
+			n, err := uc.CreateDefaultReviews(context.Background())
+			if err != nil {
+				t.Fatalf("CreateDefaultReviews() unexpected error: %v", err)
+			}
+			if n != len(tt.want) {
+				t.Fatalf("CreateDefaultReviews() = %d, want %d", n, len(tt.want))
+			}
+			var got [][2]int64
+			for _, r := range repo.saved {
+				got = append(got, [2]int64{r.OrderID, r.SkuID})
+				if r.Status != ReviewStatusApproved || r.IsDefault != 1 || r.Score != 5 {
+					t.Fatalf("default review status:%d isDefault:%d score:%d", r.Status, r.IsDefault, r.Score)
+				}
+			}
+			sort.Slice(got, func(i, j int) bool {
+				return got[i][0] < got[j][0] || got[i][0] == got[j][0] && got[i][1] < got[j][1]
+			})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("default reviews = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 业务事件类型
+const (
+	EventAppealAudited = "appeal_audited" // 申诉审核完成，通知商家审核结果
+)
+
+// 发件箱中事件的状态
+const (
+	OutboxStatusPending int32 = 10 // 待发送
+	OutboxStatusSent    int32 = 20 // 已发送
+)
+
+// 没有配置时发件箱发送的默认值
+const (
+	defaultOutboxBatchSize     = 100
+	defaultOutboxRetryInterval = 10 * time.Second // 第一次重试的间隔，之后每次翻倍
+	defaultOutboxMaxRetry      = 10 * time.Minute
+	maxOutboxErrorLen          = 512 // last_error字段的长度（字符数）
+)
+
+// Event 发给下游服务的业务事件
+type Event struct {
+	ID       int64 // 发件箱中的事件ID，事件可能重复发送，下游按ID去重
+	Type     string
+	Key      int64       // 事件的接收方，比如商家事件是店铺ID
+	Payload  interface{} // 事件内容，以json格式发送
+	CreateAt time.Time
+}
+
+// AppealAuditedPayload 申诉审核完成事件的内容
+type AppealAuditedPayload struct {
+	AppealID int64  `json:"appeal_id"`
+	ReviewID int64  `json:"review_id"`
+	StoreID  int64  `json:"store_id"`
+	Status   int32  `json:"status"`
+	OpReason string `json:"op_reason"` // 审核原因，运营的内部备注不发给商家
+	OpUser   string `json:"op_user"`
+	AuditAt  int64  `json:"audit_at"`
+}
+
+// EventPublisher 业务事件的发布
+type EventPublisher interface {
+	Publish(ctx context.Context, event *Event) error
+}
+
+// OutboxRepo 业务事件发件箱
+// 事件由各个repo在业务数据的事务中写入，保证业务操作提交后事件一定会被发送
+type OutboxRepo interface {
+	// ListPendingEvents 查询待发送并且到了发送时间的事件，按ID顺序
+	ListPendingEvents(ctx context.Context, now time.Time, limit int) ([]*model.ReviewEventOutbox, error)
+	// MarkEventSent 标记事件已发送
+	MarkEventSent(ctx context.Context, id int64) error
+	// MarkEventFailed 记录发送失败，nextRetryAt之后再次发送
+	MarkEventFailed(ctx context.Context, id int64, retryCount int32, nextRetryAt time.Time, reason string) error
+}
+
+// EventRelay 把发件箱中的事件发送到下游，由后台任务定时调用
+// 事件至少发送一次：发送成功但标记失败时会重复发送
+type EventRelay struct {
+	repo             OutboxRepo
+	publisher        EventPublisher
+	batchSize        int
+	maxRetryInterval time.Duration
+	log              *log.Helper
+}
+
+func NewEventRelay(repo OutboxRepo, publisher EventPublisher, cfg *conf.Review, logger log.Logger) *EventRelay {
+	c := cfg.GetEvent()
+	r := &EventRelay{
+		repo:             repo,
+		publisher:        publisher,
+		batchSize:        defaultOutboxBatchSize,
+		maxRetryInterval: defaultOutboxMaxRetry,
+		log:              log.NewHelper(logger),
+	}
+	if c.GetBatchSize() > 0 {
+		r.batchSize = int(c.GetBatchSize())
+	}
+	if d := c.GetMaxRetryInterval(); d != nil && d.AsDuration() > 0 {
+		r.maxRetryInterval = d.AsDuration()
+	}
+	return r
+}
+
+// RelayEvents 发送一批到期的事件，返回发送成功的事件数
+// 单个事件发送失败时按指数退避推迟重试，不影响同一批的其他事件
+func (r *EventRelay) RelayEvents(ctx context.Context) (int, error) {
+	now := time.Now()
+	rows, err := r.repo.ListPendingEvents(ctx, now, r.batchSize)
+	if err != nil {
+		return 0, err
+	}
+	sent := 0
+	for _, row := range rows {
+		event := &Event{
+			ID:       row.ID,
+			Type:     row.EventType,
+			Key:      row.EventKey,
+			Payload:  json.RawMessage(row.Payload),
+			CreateAt: row.CreateAt,
+		}
+		if err := r.publisher.Publish(ctx, event); err != nil {
+			retryCount := row.RetryCount + 1
+			r.log.WithContext(ctx).Errorf("[biz] publish event fail, id:%d type:%s retry:%d err:%v", row.ID, row.EventType, retryCount, err)
+			reason := []rune(err.Error())
+			if len(reason) > maxOutboxErrorLen {
+				reason = reason[:maxOutboxErrorLen]
+			}
+			if err := r.repo.MarkEventFailed(ctx, row.ID, retryCount, now.Add(r.retryInterval(retryCount)), string(reason)); err != nil {
+				return sent, err
+			}
+			continue
+		}
+		if err := r.repo.MarkEventSent(ctx, row.ID); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// retryInterval 第n次失败后的重试间隔，从defaultOutboxRetryInterval开始翻倍，不超过maxRetryInterval
+func (r *EventRelay) retryInterval(n int32) time.Duration {
+	d := defaultOutboxRetryInterval
+	for i := int32(1); i < n && d < r.maxRetryInterval; i++ {
+		d *= 2
+	}
+	if d > r.maxRetryInterval {
+		d = r.maxRetryInterval
+	}
+	return d
+}
@@ -0,0 +1,117 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// memoryOutboxRepo 测试用的发件箱
+type memoryOutboxRepo struct {
+	rows   []*model.ReviewEventOutbox
+	sent   []int64
+	failed map[int64]int32
+}
+
+func (r *memoryOutboxRepo) ListPendingEvents(ctx context.Context, now time.Time, limit int) ([]*model.ReviewEventOutbox, error) {
+	var ret []*model.ReviewEventOutbox
+	for _, row := range r.rows {
+		if row.Status == OutboxStatusPending && !row.NextRetryAt.After(now) && len(ret) < limit {
+			ret = append(ret, row)
+		}
+	}
+	return ret, nil
+}
+
+func (r *memoryOutboxRepo) MarkEventSent(ctx context.Context, id int64) error {
+	r.sent = append(r.sent, id)
+	return nil
+}
+
+func (r *memoryOutboxRepo) MarkEventFailed(ctx context.Context, id int64, retryCount int32, nextRetryAt time.Time, reason string) error {
+	r.failed[id] = retryCount
+	return nil
+}
+
+// failingPublisher 测试用的事件发布，发布fail中的事件时返回错误
+type failingPublisher struct {
+	fail      map[int64]bool
+	published []int64
+}
+
+func (p *failingPublisher) Publish(ctx context.Context, event *Event) error {
+	if p.fail[event.ID] {
+		return errors.New("redis unavailable")
+	}
+	p.published = append(p.published, event.ID)
+	return nil
+}
+
+func TestEventRelayRelayEvents(t *testing.T) {
+	now := time.Now()
+	pending := func(id int64, retry int32, next time.Time) *model.ReviewEventOutbox {
+		return &model.ReviewEventOutbox{ID: id, EventType: EventAppealAudited, Payload: "{}", Status: OutboxStatusPending, RetryCount: retry, NextRetryAt: next}
+	}
+	tests := []struct {
+		name       string
+		rows       []*model.ReviewEventOutbox
+		fail       map[int64]bool
+		batchSize  int32
+		wantSent   []int64
+		wantFailed map[int64]int32
+	}{
+		{"全部发送成功", []*model.ReviewEventOutbox{pending(1, 0, now), pending(2, 0, now)}, nil, 0, []int64{1, 2}, map[int64]int32{}},
+		{"失败的事件记录重试次数，不影响其他事件", []*model.ReviewEventOutbox{pending(1, 0, now), pending(2, 2, now), pending(3, 0, now)}, map[int64]bool{2: true}, 0, []int64{1, 3}, map[int64]int32{2: 3}},
+		{"没到重试时间的事件不发送", []*model.ReviewEventOutbox{pending(1, 1, now.Add(time.Minute)), pending(2, 0, now)}, nil, 0, []int64{2}, map[int64]int32{}},
+		{"已发送的事件不再发送", []*model.ReviewEventOutbox{{ID: 1, Status: OutboxStatusSent}, pending(2, 0, now)}, nil, 0, []int64{2}, map[int64]int32{}},
+		{"每轮最多发送batchSize个", []*model.ReviewEventOutbox{pending(1, 0, now), pending(2, 0, now), pending(3, 0, now)}, nil, 2, []int64{1, 2}, map[int64]int32{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &memoryOutboxRepo{rows: tt.rows, failed: make(map[int64]int32)}
+			publisher := &failingPublisher{fail: tt.fail}
+			cfg := &conf.Review{Event: &conf.Review_Event{BatchSize: tt.batchSize}}
+			relay := NewEventRelay(repo, publisher, cfg, log.DefaultLogger)
+			n, err := relay.RelayEvents(context.Background())
+			if err != nil {
+				t.Fatalf("RelayEvents() error = %v", err)
+			}
+			if n != len(tt.wantSent) {
+				t.Errorf("RelayEvents() = %d, want %d", n, len(tt.wantSent))
+			}
+			if !reflect.DeepEqual(repo.sent, tt.wantSent) {
+				t.Errorf("sent = %v, want %v", repo.sent, tt.wantSent)
+			}
+			if !reflect.DeepEqual(repo.failed, tt.wantFailed) {
+				t.Errorf("failed = %v, want %v", repo.failed, tt.wantFailed)
+			}
+		})
+	}
+}
+
+func TestEventRelayRetryInterval(t *testing.T) {
+	cfg := &conf.Review{Event: &conf.Review_Event{MaxRetryInterval: durationpb.New(time.Minute)}}
+	relay := NewEventRelay(nil, nil, cfg, log.DefaultLogger)
+	tests := []struct {
+		n    int32
+		want time.Duration
+	}{
+		{1, 10 * time.Second},
+		{2, 20 * time.Second},
+		{3, 40 * time.Second},
+		{4, time.Minute},
+		{100, time.Minute},
+	}
+	for _, tt := range tests {
+		if got := relay.retryInterval(tt.n); got != tt.want {
+			t.Errorf("retryInterval(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+}
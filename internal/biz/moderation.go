@@ -0,0 +1,296 @@
+package biz
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 命中敏感词后的处理方式
+const (
+	ModerationActionMask   = "mask"   // 敏感词替换成*
+	ModerationActionReject = "reject" // 直接拒绝
+	ModerationActionAudit  = "audit"  // 转人工审核
+)
+
+// 内容审核结论
+const (
+	VerdictPass   = "pass"   // 没有命中敏感词
+	VerdictMask   = "mask"   // 命中敏感词，已打码
+	VerdictReject = "reject" // 命中敏感词，拒绝
+	VerdictAudit  = "audit"  // 命中敏感词，需要人工审核
+)
+
+// defaultReloadInterval 没有配置时检查敏感词文件是否更新的间隔
+const defaultReloadInterval = 30 * time.Second
+
+// ModerationResult 内容审核结果
+type ModerationResult struct {
+	Verdict string   `json:"verdict"`
+	Hits    []string `json:"hits,omitempty"` // 命中的敏感词
+	Text    string   `json:"-"`              // 审核后的内容，打码时是打码后的内容
+}
+
+// CtrlInfo 保存在ctrl_json字段中的控制信息
+type CtrlInfo struct {
+	Moderation *ModerationResult `json:"moderation,omitempty"`
+	Spam       *SpamResult       `json:"spam,omitempty"`
+}
+
+// JSON 序列化成ctrl_json
+func (c *CtrlInfo) JSON() string {
+	b, _ := json.Marshal(c)
+	return string(b)
+}
+
+// Moderator 内容审核
+// 使用DFA匹配敏感词，敏感词文件更新后自动重新加载
+type Moderator struct {
+	enable         bool
+	autoApprove    bool
+	action         string
+	file           string
+	reloadInterval time.Duration
+	log            *log.Helper
+
+	mu        sync.RWMutex
+	words     *wordTrie
+	modTime   time.Time // 已加载的敏感词文件的修改时间
+	checkedAt time.Time // 上次检查文件的时间
+}
+
+func NewModerator(cfg *conf.Review, logger log.Logger) (*Moderator, error) {
+	c := cfg.GetModeration()
+	m := &Moderator{
+		enable:         c.GetEnable(),
+		autoApprove:    c.GetAutoApprove(),
+		action:         c.GetAction(),
+		file:           c.GetWordFile(),
+		reloadInterval: defaultReloadInterval,
+		log:            log.NewHelper(logger),
+		words:          newWordTrie(),
+	}
+	if d := c.GetReloadInterval(); d != nil && d.AsDuration() > 0 {
+		m.reloadInterval = d.AsDuration()
+	}
+	switch m.action {
+	case ModerationActionMask, ModerationActionReject, ModerationActionAudit:
+	case "":
+		m.action = ModerationActionAudit
+	default:
+		return nil, fmt.Errorf("invalid moderation action:%s", m.action)
+	}
+	if m.enable && m.file != "" {
+		if err := m.load(); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// AutoApprove 没有命中敏感词的评价是否自动审核通过
+func (m *Moderator) AutoApprove() bool {
+	return m.enable && m.autoApprove
+}
+
+// Check 审核一段内容
+func (m *Moderator) Check(text string) *ModerationResult {
+	if !m.enable {
+		return &ModerationResult{Verdict: VerdictPass, Text: text}
+	}
+	m.reloadIfChanged()
+	m.mu.RLock()
+	words := m.words
+	m.mu.RUnlock()
+
+	masked, hits := words.match(text)
+	if len(hits) == 0 {
+		return &ModerationResult{Verdict: VerdictPass, Text: text}
+	}
+	switch m.action {
+	case ModerationActionMask:
+		return &ModerationResult{Verdict: VerdictMask, Hits: hits, Text: masked}
+	case ModerationActionReject:
+		return &ModerationResult{Verdict: VerdictReject, Hits: hits, Text: text}
+	default:
+		return &ModerationResult{Verdict: VerdictAudit, Hits: hits, Text: text}
+	}
+}
+
+// reloadIfChanged 每隔reloadInterval检查一次敏感词文件，文件有修改就重新加载
+func (m *Moderator) reloadIfChanged() {
+	if m.file == "" {
+		return
+	}
+	m.mu.Lock()
+	if time.Since(m.checkedAt) < m.reloadInterval {
+		m.mu.Unlock()
+		return
+	}
+	m.checkedAt = time.Now()
+	modTime := m.modTime
+	m.mu.Unlock()
+
+	info, err := os.Stat(m.file)
+	if err != nil {
+		m.log.Errorf("stat word file fail, file:%s err:%v", m.file, err)
+		return
+	}
+	if info.ModTime().Equal(modTime) {
+		return
+	}
+	// 加载失败时继续使用原来的敏感词
+	if err := m.load(); err != nil {
+		m.log.Errorf("reload word file fail, file:%s err:%v", m.file, err)
+	}
+}
+
+// load 加载敏感词文件，一行一个词，#开头的行是注释
+func (m *Moderator) load() error {
+	f, err := os.Open(m.file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	words := newWordTrie()
+	var n int
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		word := strings.TrimSpace(scanner.Text())
+		if word == "" || strings.HasPrefix(word, "#") {
+			continue
+		}
+		words.add(word)
+		n++
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	m.words = words
+	m.modTime = info.ModTime()
+	m.checkedAt = time.Now()
+	m.mu.Unlock()
+	m.log.Infof("load %d sensitive words from %s", n, m.file)
+	return nil
+}
+
+// wordTrie 敏感词DFA（字典树），加载完成后只读
+type wordTrie struct {
+	root *trieNode
+}
+
+type trieNode struct {
+	children map[rune]*trieNode
+	end      bool // 是否是一个敏感词的结尾
+}
+
+func newWordTrie() *wordTrie {
+	return &wordTrie{root: &trieNode{children: map[rune]*trieNode{}}}
+}
+
+func (t *wordTrie) add(word string) {
+	node := t.root
+	for _, r := range word {
+		r = unicode.ToLower(r)
+		next, ok := node.children[r]
+		if !ok {
+			next = &trieNode{children: map[rune]*trieNode{}}
+			node.children[r] = next
+		}
+		node = next
+	}
+	node.end = true
+}
+
+// match 查找text中所有的敏感词（不区分大小写，优先匹配最长的词），返回打码后的内容和命中的敏感词
+func (t *wordTrie) match(text string) (string, []string) {
+	runes := []rune(text)
+	masked := make([]rune, len(runes))
+	copy(masked, runes)
+	var hits []string
+	seen := make(map[string]struct{})
+	for i := 0; i < len(runes); {
+		node := t.root
+		end := -1
+		for j := i; j < len(runes); j++ {
+			next, ok := node.children[unicode.ToLower(runes[j])]
+			if !ok {
+				break
+			}
+			node = next
+			if node.end {
+				end = j
+			}
+		}
+		if end < 0 {
+			i++
+			continue
+		}
+		word := string(runes[i : end+1])
+		if _, ok := seen[word]; !ok {
+			seen[word] = struct{}{}
+			hits = append(hits, word)
+		}
+		for k := i; k <= end; k++ {
+			masked[k] = '*'
+		}
+		i = end + 1
+	}
+	return string(masked), hits
+}
+
+// moderateReview 审核评价内容，并根据审核结果决定评价的初始状态
+// 命中敏感词时按配置拒绝、打码或者转人工审核，没有命中时按配置自动审核通过
+func (uc *ReviewUsecase) moderateReview(review *model.ReviewInfo, ctrl *CtrlInfo) error {
+	ret := uc.moderator.Check(review.Content)
+	switch ret.Verdict {
+	case VerdictReject:
+		return v1.ErrorContentSensitive("评价内容包含敏感词:%s", strings.Join(ret.Hits, ","))
+	case VerdictAudit:
+		// 保持待审核状态，由运营人工审核
+		review.Status = ReviewStatusPending
+	default:
+		review.Content = ret.Text
+		if uc.moderator.AutoApprove() {
+			review.Status = ReviewStatusApproved
+		}
+	}
+	ctrl.Moderation = ret
+	return nil
+}
+
+// moderateReply 审核商家回复的内容
+// 回复没有人工审核流程，所以转人工审核的处理方式也直接拒绝
+func (uc *ReviewUsecase) moderateReply(reply *model.ReviewReplyInfo) error {
+	ret := uc.moderator.Check(reply.Content)
+	switch ret.Verdict {
+	case VerdictReject, VerdictAudit:
+		return v1.ErrorContentSensitive("回复内容包含敏感词:%s", strings.Join(ret.Hits, ","))
+	}
+	reply.Content = ret.Text
+	reply.CtrlJSON = (&CtrlInfo{Moderation: ret}).JSON()
+	return nil
+}
+
+// moderateAppeal 审核商家申诉的内容
+// 申诉本身都要经过运营人工审核，这里只记录命中的敏感词供审核时参考，不修改内容
+func (uc *ReviewUsecase) moderateAppeal(param *AppealParam) {
+	ret := uc.moderator.Check(param.Reason + "\n" + param.Content)
+	ret.Text = ""
+	param.CtrlJSON = (&CtrlInfo{Moderation: ret}).JSON()
+}
@@ -0,0 +1,99 @@
+package biz
+
+import (
+	"reflect"
+	"review-service/internal/conf"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func newTestTrie(words ...string) *wordTrie {
+	t := newWordTrie()
+	for _, w := range words {
+		t.add(w)
+	}
+	return t
+}
+
+func TestWordTrieMatch(t *testing.T) {
+	trie := newTestTrie("傻", "傻瓜", "垃圾", "Fake")
+	tests := []struct {
+		name       string
+		text       string
+		wantMasked string
+		wantHits   []string
+	}{
+		{"没有敏感词", "质量很好，物流很快", "质量很好，物流很快", nil},
+		{"空内容", "", "", nil},
+		{"单个敏感词", "这是垃圾", "这是**", []string{"垃圾"}},
+		{"优先匹配最长的词", "你是傻瓜吗", "你是**吗", []string{"傻瓜"}},
+		{"短词单独出现", "有点傻", "有点*", []string{"傻"}},
+		{"多个敏感词", "垃圾东西，傻瓜才买", "**东西，**才买", []string{"垃圾", "傻瓜"}},
+		{"重复命中只记录一次", "垃圾垃圾", "****", []string{"垃圾"}},
+		{"不区分大小写", "this is FAKE", "this is ****", []string{"FAKE"}},
+		{"前缀不完整不算命中", "Fak", "Fak", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			masked, hits := trie.match(tt.text)
+			if masked != tt.wantMasked {
+				t.Fatalf("match(%q) masked = %q, want %q", tt.text, masked, tt.wantMasked)
+			}
+			if !reflect.DeepEqual(hits, tt.wantHits) {
+				t.Fatalf("match(%q) hits = %v, want %v", tt.text, hits, tt.wantHits)
+			}
+		})
+	}
+}
+
+func TestModeratorCheck(t *testing.T) {
+	tests := []struct {
+		name        string
+		enable      bool
+		action      string
+		text        string
+		wantVerdict string
+		wantText    string
+	}{
+		{"未开启", false, ModerationActionReject, "这是垃圾", VerdictPass, "这是垃圾"},
+		{"没有命中", true, ModerationActionReject, "很好", VerdictPass, "很好"},
+		{"打码", true, ModerationActionMask, "这是垃圾", VerdictMask, "这是**"},
+		{"拒绝", true, ModerationActionReject, "这是垃圾", VerdictReject, "这是垃圾"},
+		{"转人工审核", true, ModerationActionAudit, "这是垃圾", VerdictAudit, "这是垃圾"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Moderator{enable: tt.enable, action: tt.action, words: newTestTrie("垃圾")}
+			ret := m.Check(tt.text)
+			if ret.Verdict != tt.wantVerdict || ret.Text != tt.wantText {
+				t.Fatalf("Check(%q) = %s %q, want %s %q", tt.text, ret.Verdict, ret.Text, tt.wantVerdict, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestNewModeratorAction(t *testing.T) {
+	tests := []struct {
+		name    string
+		action  string
+		want    string
+		wantErr bool
+	}{
+		{"默认转人工审核", "", ModerationActionAudit, false},
+		{"打码", ModerationActionMask, ModerationActionMask, false},
+		{"无效的处理方式", "drop", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &conf.Review{Moderation: &conf.Review_Moderation{Action: tt.action}}
+			m, err := NewModerator(cfg, log.DefaultLogger)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewModerator() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && m.action != tt.want {
+				t.Fatalf("NewModerator() action = %s, want %s", m.action, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package biz
+
+import (
+	"context"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/data/model"
+)
+
+// 操作日志的操作对象
+const (
+	OpTargetReview int32 = 1 // 评价
+	OpTargetAppeal int32 = 2 // 申诉
+	OpTargetAppend int32 = 3 // 追评，每条评价只有一条追评，用评价ID定位
+)
+
+// 操作日志的操作类型
+const (
+	OpActionAuditReview    = "audit_review"    // 审核评价
+	OpActionUpdateReview   = "update_review"   // 用户修改评价
+	OpActionAuditAppend    = "audit_append"    // 审核追评
+	OpActionAppeal         = "appeal"          // 商家申诉
+	OpActionWithdrawAppeal = "withdraw_appeal" // 商家撤回申诉
+	OpActionAuditAppeal    = "audit_appeal"    // 审核申诉
+	OpActionHideReview     = "hide_review"     // 申诉通过隐藏评价
+	OpActionDeleteReview   = "delete_review"   // 删除评价
+	OpActionRestoreReview  = "restore_review"  // 恢复评价
+)
+
+// ListOperationLogs 查询评价和申诉的操作日志，按操作时间倒序
+func (uc *ReviewUsecase) ListOperationLogs(ctx context.Context, param *ListOperationLogParam) ([]*model.ReviewOperationLog, int64, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListOperationLogs param:%v", param)
+	if !param.StartTime.IsZero() && !param.EndTime.IsZero() && param.EndTime.Before(param.StartTime) {
+		return nil, 0, v1.ErrorParamInvalid("结束时间不能早于开始时间")
+	}
+	if param.Page <= 0 {
+		param.Page = 1
+	}
+	if param.Size <= 0 || param.Size > 50 {
+		param.Size = 10
+	}
+	return uc.repo.ListOperationLogs(ctx, param, (param.Page-1)*param.Size, param.Size)
+}
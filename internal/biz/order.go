@@ -0,0 +1,118 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/data/model"
+)
+
+// Order 订单信息
+type Order struct {
+	OrderID   int64
+	UserID    int64
+	StoreID   int64
+	Completed bool // 订单是否已完成，只有已完成的订单才能评价
+	Items     []*OrderItem
+}
+
+// Sku 商品信息
+type Sku struct {
+	SkuID   int64
+	SpuID   int64
+	StoreID int64
+	Title   string
+	Picture string
+	Price   int64 // 单位：分
+	Specs   string
+}
+
+// OrderClient 订单服务
+type OrderClient interface {
+	// GetOrder 查询订单，订单不存在时返回ORDER_INVALID错误
+	GetOrder(ctx context.Context, orderID int64) (*Order, error)
+}
+
+// GoodsClient 商品服务
+type GoodsClient interface {
+	// GetSku 查询sku，sku不存在时返回ORDER_INVALID错误
+	GetSku(ctx context.Context, skuID int64) (*Sku, error)
+}
+
+// User 用户信息
+type User struct {
+	UserID   int64
+	Nickname string
+}
+
+// UserClient 用户服务
+type UserClient interface {
+	// GetUser 查询用户
+	GetUser(ctx context.Context, userID int64) (*User, error)
+}
+
+// GoodsSnapshot 评价时的商品快照，以json格式保存在goods_snapshoot字段
+type GoodsSnapshot struct {
+	SkuID   int64  `json:"sku_id"`
+	SpuID   int64  `json:"spu_id"`
+	Title   string `json:"title"`
+	Picture string `json:"picture"`
+	Price   int64  `json:"price"`
+	Specs   string `json:"specs"`
+}
+
+// fillOrderInfo 查询订单和商品信息，校验用户确实购买了评价的商品，并补全评价的店铺、spu和商品快照
+// reviews必须属于同一个订单
+func (uc *ReviewUsecase) fillOrderInfo(ctx context.Context, reviews ...*model.ReviewInfo) error {
+	if len(reviews) == 0 {
+		return nil
+	}
+	first := reviews[0]
+	order, err := uc.orders.GetOrder(ctx, first.OrderID)
+	if err != nil {
+		return err
+	}
+	// 水平越权校验：只能评价自己的订单
+	if order.UserID != first.UserID {
+		return v1.ErrorUserForbidden("用户:%d无权评价订单:%d", first.UserID, first.OrderID)
+	}
+	if !order.Completed {
+		return v1.ErrorOrderInvalid("订单:%d还没有完成，不能评价", order.OrderID)
+	}
+	items := make(map[int64]*OrderItem, len(order.Items))
+	for _, item := range order.Items {
+		items[item.SkuID] = item
+	}
+	for _, review := range reviews {
+		// 请求中的店铺必须是订单所属的店铺
+		if review.StoreID != order.StoreID {
+			return v1.ErrorParamInvalid("店铺:%d和订单:%d不一致", review.StoreID, order.OrderID)
+		}
+		item, ok := items[review.SkuID]
+		if !ok {
+			return v1.ErrorOrderInvalid("订单:%d中没有商品:%d", order.OrderID, review.SkuID)
+		}
+		sku, err := uc.goods.GetSku(ctx, review.SkuID)
+		if err != nil {
+			return err
+		}
+		// 商品也必须是这个店铺售卖的
+		if sku.StoreID != order.StoreID {
+			return v1.ErrorOrderInvalid("商品:%d不属于订单:%d的店铺:%d", review.SkuID, order.OrderID, order.StoreID)
+		}
+		snapshot, err := json.Marshal(&GoodsSnapshot{
+			SkuID:   sku.SkuID,
+			SpuID:   item.SpuID,
+			Title:   sku.Title,
+			Picture: sku.Picture,
+			Price:   sku.Price,
+			Specs:   sku.Specs,
+		})
+		if err != nil {
+			return err
+		}
+		review.SpuID = item.SpuID
+		review.GoodsSnapshoot = string(snapshot)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/data/model"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// memoryOrderClient 内存中的订单服务，用于测试
+type memoryOrderClient struct {
+	orders map[int64]*Order
+}
+
+// newMemoryOrderClient 使用给定的订单创建内存中的订单服务
+func newMemoryOrderClient(orders ...*Order) *memoryOrderClient {
+	c := &memoryOrderClient{orders: make(map[int64]*Order, len(orders))}
+	for _, order := range orders {
+		c.orders[order.OrderID] = order
+	}
+	return c
+}
+
+func (c *memoryOrderClient) GetOrder(ctx context.Context, orderID int64) (*Order, error) {
+	order, ok := c.orders[orderID]
+	if !ok {
+		return nil, v1.ErrorOrderInvalid("订单:%d不存在", orderID)
+	}
+	return order, nil
+}
+
+// memoryGoodsClient 内存中的商品服务，用于测试
+type memoryGoodsClient struct {
+	skus map[int64]*Sku
+}
+
+// newMemoryGoodsClient 使用给定的sku创建内存中的商品服务
+func newMemoryGoodsClient(skus ...*Sku) *memoryGoodsClient {
+	c := &memoryGoodsClient{skus: make(map[int64]*Sku, len(skus))}
+	for _, sku := range skus {
+		c.skus[sku.SkuID] = sku
+	}
+	return c
+}
+
+func (c *memoryGoodsClient) GetSku(ctx context.Context, skuID int64) (*Sku, error) {
+	sku, ok := c.skus[skuID]
+	if !ok {
+		return nil, v1.ErrorOrderInvalid("商品:%d不存在", skuID)
+	}
+	return sku, nil
+}
+
+func TestFillOrderInfo(t *testing.T) {
+	orders := newMemoryOrderClient(
+		&Order{OrderID: 1, UserID: 200, StoreID: 100, Completed: true, Items: []*OrderItem{{SkuID: 10, SpuID: 1}, {SkuID: 11, SpuID: 1}, {SkuID: 12, SpuID: 2}, {SkuID: 13, SpuID: 3}}},
+		&Order{OrderID: 2, UserID: 200, StoreID: 100, Completed: false, Items: []*OrderItem{{SkuID: 10, SpuID: 1}}},
+	)
+	goods := newMemoryGoodsClient(
+		&Sku{SkuID: 10, SpuID: 1, StoreID: 100, Title: "T恤", Picture: "a.jpg", Price: 9900, Specs: "白色 L"},
+		&Sku{SkuID: 11, SpuID: 1, StoreID: 100, Title: "T恤", Picture: "b.jpg", Price: 9900, Specs: "黑色 L"},
+		&Sku{SkuID: 12, SpuID: 2, StoreID: 101, Title: "帽子"},
+	)
+	review := func(orderID, userID, storeID, skuID int64) *model.ReviewInfo {
+		return &model.ReviewInfo{OrderID: orderID, UserID: userID, StoreID: storeID, SkuID: skuID}
+	}
+	tests := []struct {
+		name    string
+		reviews []*model.ReviewInfo
+		wantErr func(error) bool
+	}{
+		{"订单不存在", []*model.ReviewInfo{review(3, 200, 100, 10)}, v1.IsOrderInvalid},
+		{"不是自己的订单", []*model.ReviewInfo{review(1, 201, 100, 10)}, v1.IsUserForbidden},
+		{"订单还没有完成", []*model.ReviewInfo{review(2, 200, 100, 10)}, v1.IsOrderInvalid},
+		{"订单中没有这个商品", []*model.ReviewInfo{review(1, 200, 100, 20)}, v1.IsOrderInvalid},
+		{"店铺和订单不一致", []*model.ReviewInfo{review(1, 200, 101, 10)}, v1.IsParamInvalid},
+		{"没有传店铺", []*model.ReviewInfo{review(1, 200, 0, 10)}, v1.IsParamInvalid},
+		{"商品不是订单店铺售卖的", []*model.ReviewInfo{review(1, 200, 100, 12)}, v1.IsOrderInvalid},
+		{"商品服务中没有这个商品", []*model.ReviewInfo{review(1, 200, 100, 13)}, v1.IsOrderInvalid},
+		{"批量评价中有一个商品不合法", []*model.ReviewInfo{review(1, 200, 100, 10), review(1, 200, 100, 20)}, v1.IsOrderInvalid},
+		{"正常评价", []*model.ReviewInfo{review(1, 200, 100, 10)}, nil},
+		{"批量评价", []*model.ReviewInfo{review(1, 200, 100, 10), review(1, 200, 100, 11)}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &ReviewUsecase{orders: orders, goods: goods, log: log.NewHelper(log.DefaultLogger)}
+			err := uc.fillOrderInfo(context.Background(), tt.reviews...)
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Fatalf("fillOrderInfo() error = %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("fillOrderInfo() unexpected error: %v", err)
+			}
+			for _, r := range tt.reviews {
+				sku := goods.skus[r.SkuID]
+				if r.SpuID != sku.SpuID {
+					t.Fatalf("review sku:%d spuID = %d, want %d", r.SkuID, r.SpuID, sku.SpuID)
+				}
+				var snapshot GoodsSnapshot
+				if err := json.Unmarshal([]byte(r.GoodsSnapshoot), &snapshot); err != nil {
+					t.Fatalf("review sku:%d snapshot %q: %v", r.SkuID, r.GoodsSnapshoot, err)
+				}
+				want := GoodsSnapshot{SkuID: sku.SkuID, SpuID: sku.SpuID, Title: sku.Title, Picture: sku.Picture, Price: sku.Price, Specs: sku.Specs}
+				if snapshot != want {
+					t.Fatalf("review sku:%d snapshot = %+v, want %+v", r.SkuID, snapshot, want)
+				}
+			}
+		})
+	}
+}
@@ -1,5 +1,13 @@
 package biz
 
+import "time"
+
+// GetReviewParam 查询评价详情的参数
+// 调用方身份从ctx中获取，评价所属店铺的商家和运营查看时才返回申诉信息
+type GetReviewParam struct {
+	ReviewID int64
+}
+
 // ReplyParam 商家回复评价的参数
 type ReplyParam struct {
 	ReviewID  int64
@@ -7,17 +15,73 @@ type ReplyParam struct {
 	Content   string
 	PicInfo   string
 	VideoInfo string
+	Auto      bool // 自动回复，只回复从来没有回复过的评价
+}
+
+// UpdateReplyParam 商家修改回复的参数
+type UpdateReplyParam struct {
+	ReplyID   int64
+	StoreID   int64
+	Content   string
+	PicInfo   string
+	VideoInfo string
+}
+
+// DeleteReplyParam 商家撤回回复的参数
+type DeleteReplyParam struct {
+	ReplyID int64
+	StoreID int64
 }
 
 // AuditParam 运营审核评价的参数
 type AuditParam struct {
 	ReviewID  int64
-	OpUser    string
+	OpUser    string // 审核人，由biz根据调用方身份填写
 	OpReason  string
 	OpRemarks string
 	Status    int32
 }
 
+// UpdateReviewParam 用户修改评价的参数
+type UpdateReviewParam struct {
+	ReviewID     int64
+	UserID       int64
+	Score        int32
+	ServiceScore int32
+	ExpressScore int32
+	Content      string
+	PicInfo      string
+	VideoInfo    string
+	Status       int32  // 敏感词审核和刷评检测之后的状态，由biz层填充
+	CtrlJSON     string // 敏感词审核和刷评检测的结果，由biz层填充
+}
+
+// AppendParam 用户追评的参数
+type AppendParam struct {
+	ReviewID  int64
+	UserID    int64
+	Content   string
+	PicInfo   string
+	VideoInfo string
+}
+
+// AuditAppendParam 运营审核追评的参数
+type AuditAppendParam struct {
+	ReviewID  int64
+	OpUser    string // 审核人，由biz根据调用方身份填写
+	OpReason  string
+	OpRemarks string
+	Status    int32
+}
+
+// DeleteReviewParam 删除/恢复评价的参数
+// 作者只能操作自己的评价，运营可以操作任意评价
+type DeleteReviewParam struct {
+	ReviewID int64
+	UserID   int64  // 操作的作者，由biz根据调用方身份填写
+	OpUser   string // 操作的运营，由biz根据调用方身份填写
+}
+
 // AppealParam 商家申诉评价的参数
 type AppealParam struct {
 	ReviewID  int64
@@ -27,12 +91,119 @@ type AppealParam struct {
 	PicInfo   string
 	VideoInfo string
 	OpUser    string
+	CtrlJSON  string // 敏感词审核结果
 }
 
 // AuditAppealParam O端审核商家申诉的参数
 type AuditAppealParam struct {
-	ReviewID int64
-	AppealID int64
-	OpUser   string
-	Status   int32
+	ReviewID  int64
+	AppealID  int64
+	OpUser    string // 审核人，由biz根据调用方身份填写
+	Status    int32
+	OpReason  string // 审核原因，驳回时必填
+	OpRemarks string // 运营内部备注，不通知商家
+}
+
+// 评价列表的排序方式
+const (
+	ReviewSortNewest    int32 = iota // 最新的在前（默认）
+	ReviewSortOldest                 // 最早的在前
+	ReviewSortScoreAsc               // 评分从低到高
+	ReviewSortScoreDesc              // 评分从高到低
+)
+
+// ListReviewParam 分页查询评价列表的参数
+// 指针类型的筛选条件为nil表示不限，数值类型的筛选条件为零值表示不限
+type ListReviewParam struct {
+	StoreID   int64
+	SpuID     int64
+	SkuID     int64
+	MinScore  int32
+	MaxScore  int32
+	HasMedia  *int32
+	HasReply  *int32
+	Status    *int32
+	Anonymous *int32
+	// HasContent 是否是用户填写的评价，系统生成的默认好评不算有内容
+	HasContent *int32
+	StartTime  time.Time // 评价创建时间范围
+	EndTime    time.Time
+	Sort       int32
+	Page       int
+	Size       int
+}
+
+// 评分档位
+const (
+	ScoreLevelAll     int32 = iota // 全部
+	ScoreLevelGood                 // 好评：4-5分
+	ScoreLevelNeutral              // 中评：3分
+	ScoreLevelBad                  // 差评：1-2分
+)
+
+// ListSpuReviewParam 商品详情页查询评价的参数（按游标翻页）
+type ListSpuReviewParam struct {
+	SpuID       int64
+	SkuID       int64
+	OnlyMedia   bool // 只看有图/视频的评价
+	OnlyContent bool // 只看用户填写的评价，不看默认好评
+	ScoreLevel  int32
+	Cursor      string // 上一页返回的游标，第一页传空
+	Size        int
+}
+
+// ReviewPage 按游标翻页的评价列表
+type ReviewPage struct {
+	List       []*MyReviewInfo
+	Total      int64
+	NextCursor string // 为空表示没有下一页了
+}
+
+// ListOperationLogParam 查询操作日志的参数，零值表示不限
+type ListOperationLogParam struct {
+	ReviewID  int64
+	Actor     string
+	StartTime time.Time // 操作时间范围[StartTime, EndTime)
+	EndTime   time.Time
+	Page      int
+	Size      int
+}
+
+// ListAppealParam 查询申诉的参数，零值表示不限
+type ListAppealParam struct {
+	StoreID   int64
+	Status    int32
+	Reason    string
+	StartTime time.Time // 申诉时间范围[StartTime, EndTime)
+	EndTime   time.Time
+	Page      int
+	Size      int
+}
+
+// AppealReasonStatParam 申诉原因统计的参数，零值表示不限
+type AppealReasonStatParam struct {
+	StoreID   int64
+	StartTime time.Time // 申诉时间范围[StartTime, EndTime)
+	EndTime   time.Time
+}
+
+// ReplyTemplateParam 创建和修改回复模板的参数
+type ReplyTemplateParam struct {
+	TemplateID int64
+	StoreID    int64
+	Name       string
+	Content    string
+}
+
+// AutoReplyRuleParam 创建和修改自动回复规则的参数
+type AutoReplyRuleParam struct {
+	RuleID      int64
+	StoreID     int64
+	TemplateID  int64
+	MinScore    int32
+	MaxScore    int32
+	ContentType int32
+	Delay       int32 // 秒
+	Priority    int32
+	Enable      bool
 }
@@ -2,8 +2,8 @@ package biz
 
 import (
 	"context"
-	"fmt"
 	v1 "review-service/api/review/v1"
+	"review-service/internal/conf"
 	"review-service/internal/data/model"
 	"review-service/pkg/snowflake"
 	"strings"
@@ -14,26 +14,94 @@ import (
 
 type ReviewRepo interface {
 	SaveReview(context.Context, *model.ReviewInfo) (*model.ReviewInfo, error)
+	SaveReviews(context.Context, []*model.ReviewInfo) error
 	GetReview(context.Context, int64) (*model.ReviewInfo, error)
 	GetReviewByOrderID(context.Context, int64) ([]*model.ReviewInfo, error)
+	ListReviewByIDs(context.Context, []int64) ([]*model.ReviewInfo, error)
+	GetDeletedReview(context.Context, int64) (*model.ReviewInfo, error)
+	UpdateReview(context.Context, *model.ReviewInfo, *UpdateReviewParam) error
+	SaveAppend(context.Context, *model.ReviewAppendInfo) (*model.ReviewAppendInfo, error)
+	GetAppendByReviewID(context.Context, int64) (*model.ReviewAppendInfo, error)
+	ListAppendByReviewIDs(context.Context, []int64) ([]*model.ReviewAppendInfo, error)
+	AuditAppend(context.Context, *model.ReviewInfo, *model.ReviewAppendInfo, *AuditAppendParam) error
+	ListReviewHistory(context.Context, int64) ([]*model.ReviewHistory, error)
+	DeleteReview(context.Context, *model.ReviewInfo, *DeleteReviewParam) error
+	RestoreReview(context.Context, *model.ReviewInfo, *DeleteReviewParam) error
+	ListReviewByOrderIDs(context.Context, []int64) ([]*model.ReviewInfo, error)
+	ListReviewByOrderIDsWithDeleted(context.Context, []int64) ([]*model.ReviewInfo, error)
 
-	SaveReply(context.Context, *model.ReviewReplyInfo) (*model.ReviewReplyInfo, error)
+	// SaveReply 保存回复，auto为true时商家撤回过回复的评价也当作已回复
+	SaveReply(ctx context.Context, reply *model.ReviewReplyInfo, auto bool) (*model.ReviewReplyInfo, error)
 
+	AuditReview(context.Context, *AuditParam) error
+
+	GetAppeal(context.Context, int64) (*model.ReviewAppealInfo, error)
+	GetAppealByReviewID(context.Context, int64) (*model.ReviewAppealInfo, error)
 	AppealReview(context.Context, *AppealParam) (*model.ReviewAppealInfo, error)
-	AuditAppeal(context.Context, *AuditAppealParam) error
+	AuditAppeal(context.Context, *AuditAppealParam, *Event) error // 事件写入发件箱，和审核结果在同一个事务中提交
+	WithdrawAppeal(context.Context, *model.ReviewAppealInfo) error
+	ListAppeals(ctx context.Context, param *ListAppealParam, offset, limit int) ([]*model.ReviewAppealInfo, int64, error)
+	ListAppealByReviewID(ctx context.Context, reviewID int64) ([]*model.ReviewAppealInfo, error)
+	CountAppealByReason(ctx context.Context, param *AppealReasonStatParam) ([]*AppealReasonCount, error)
 
-	ListReviewByStoreID(ctx context.Context, storeID int64, offset, limit int) ([]*MyReviewInfo, error)
+	ListReviewByStoreID(ctx context.Context, param *ListReviewParam, offset, limit int) ([]*MyReviewInfo, int64, error)
+	ListReviewBySpu(ctx context.Context, param *ListReviewParam, cursor string, limit int) (*ReviewPage, error)
+	ListReviewByUserID(ctx context.Context, userID int64, offset, limit int) ([]*model.ReviewInfo, int64, error)
+	ListReplyByReviewIDs(ctx context.Context, reviewIDs []int64) ([]*model.ReviewReplyInfo, error)
+	ListPendingReviews(ctx context.Context, offset, limit int) ([]*model.ReviewInfo, int64, error)
+	ListPendingAppeals(ctx context.Context, offset, limit int) ([]*model.ReviewAppealInfo, int64, error)
+	ListOperationLogs(ctx context.Context, param *ListOperationLogParam, offset, limit int) ([]*model.ReviewOperationLog, int64, error)
+	GetReply(ctx context.Context, replyID int64) (*model.ReviewReplyInfo, error)
+	UpdateReply(ctx context.Context, reply *model.ReviewReplyInfo, updated *model.ReviewReplyInfo) error
+	DeleteReply(ctx context.Context, reply *model.ReviewReplyInfo) error
+	ListReplyByStoreID(ctx context.Context, storeID int64, offset, limit int) ([]*model.ReviewReplyInfo, int64, error)
 }
 
+// defaultEditWindow 没有配置时评价发布后允许修改的时间
+const defaultEditWindow = 24 * time.Hour
+
+// defaultReplyEditWindow 没有配置时商家回复后允许修改和撤回的时间
+const defaultReplyEditWindow = 24 * time.Hour
+
 type ReviewUsecase struct {
-	repo ReviewRepo
-	log  *log.Helper
+	repo            ReviewRepo
+	orders          OrderClient
+	goods           GoodsClient
+	moderator       *Moderator
+	spam            *SpamChecker
+	audit           *AuditQueue
+	reasons         *AppealReasonCatalog
+	log             *log.Helper
+	editWindow      time.Duration // 评价发布后允许修改的时间窗口
+	replyEditWindow time.Duration // 商家回复后允许修改和撤回的时间窗口
+	appealWindow    time.Duration // 评价发布后允许商家申诉的时间窗口，0表示不限制
 }
 
-func NewReviewUsecase(repo ReviewRepo, logger log.Logger) *ReviewUsecase {
+func NewReviewUsecase(repo ReviewRepo, orders OrderClient, goods GoodsClient, moderator *Moderator, spam *SpamChecker, audit *AuditQueue, reasons *AppealReasonCatalog, cfg *conf.Review, logger log.Logger) *ReviewUsecase {
+	editWindow := defaultEditWindow
+	if d := cfg.GetEditWindow(); d != nil && d.AsDuration() > 0 {
+		editWindow = d.AsDuration()
+	}
+	replyEditWindow := defaultReplyEditWindow
+	if d := cfg.GetReplyEditWindow(); d != nil && d.AsDuration() > 0 {
+		replyEditWindow = d.AsDuration()
+	}
+	var appealWindow time.Duration
+	if d := cfg.GetAppealWindow(); d != nil && d.AsDuration() > 0 {
+		appealWindow = d.AsDuration()
+	}
 	return &ReviewUsecase{
-		repo: repo,
-		log:  log.NewHelper(logger),
+		repo:            repo,
+		orders:          orders,
+		goods:           goods,
+		moderator:       moderator,
+		spam:            spam,
+		audit:           audit,
+		reasons:         reasons,
+		log:             log.NewHelper(logger),
+		editWindow:      editWindow,
+		replyEditWindow: replyEditWindow,
+		appealWindow:    appealWindow,
 	}
 }
 
@@ -49,25 +117,274 @@ func (uc *ReviewUsecase) CreateReview(ctx context.Context, review *model.ReviewI
 	if err != nil {
 		return nil, v1.ErrorDbFailed("查询数据库失败")
 	}
-	if len(reviews) > 0 {
-		// 已经评价过
-		fmt.Printf("订单已评价, len(reviews):%d\n", len(reviews))
-		return nil, v1.ErrorOrderReviewed("订单:%d已评价", review.OrderID)
+	for _, r := range reviews {
+		// 订单中的每个商品只能评价一次
+		if r.SkuID == review.SkuID {
+			uc.log.WithContext(ctx).Infof("order sku already reviewed, orderID:%d skuID:%d reviewID:%d", review.OrderID, review.SkuID, r.ReviewID)
+			return nil, v1.ErrorOrderReviewed("订单:%d的商品:%d已评价", review.OrderID, review.SkuID)
+		}
 	}
 	// 2、生成review ID
 	// 这里可以使用雪花算法自己生成
 	// 也可以直接接入公司内部的分布式ID生成服务（前提是公司内部有这种服务）
 	review.ReviewID = snowflake.GenID()
+	// 新创建的评价一律是初始状态（待审核）
+	review.Status = ReviewStatusMachine.Initial()
 	// 3、查询订单和商品快照信息
-	// 实际业务场景下就需要查询订单服务和商家服务（比如说通过RPC调用订单服务和商家服务）
-	// 4、拼装数据入库
-	return uc.repo.SaveReview(ctx, review)
+	// 通过RPC调用订单服务和商品服务，校验订单属于该用户并且已完成，保存评价时的商品快照
+	if err := uc.fillOrderInfo(ctx, review); err != nil {
+		return nil, err
+	}
+	// 4、敏感词审核，决定评价是否自动审核通过
+	ctrl := &CtrlInfo{}
+	if err := uc.moderateReview(review, ctrl); err != nil {
+		return nil, err
+	}
+	// 5、重复内容和发布频率检测，疑似刷评的转人工审核
+	uc.checkSpam(ctx, review, 1, nil, ctrl)
+	review.CtrlJSON = ctrl.JSON()
+	// 6、拼装数据入库
+	ret, err := uc.repo.SaveReview(ctx, review)
+	if err != nil {
+		return nil, err
+	}
+	uc.spam.Record(ctx, ret)
+	return ret, nil
+}
+
+// BatchCreateReviews 一次评价订单中的多个商品，所有评价在同一个事务中创建，要么全部成功要么全部失败
+func (uc *ReviewUsecase) BatchCreateReviews(ctx context.Context, orderID int64, reviews []*model.ReviewInfo) ([]*model.ReviewInfo, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] BatchCreateReviews orderID:%d len(reviews):%d", orderID, len(reviews))
+	if len(reviews) == 0 || len(reviews) > MaxBatchSize {
+		return nil, v1.ErrorParamInvalid("一次评价的商品个数必须在1到%d之间", MaxBatchSize)
+	}
+	skus := make(map[int64]struct{}, len(reviews))
+	for _, review := range reviews {
+		if review.OrderID != orderID {
+			return nil, v1.ErrorParamInvalid("评价的订单:%d和请求的订单:%d不一致", review.OrderID, orderID)
+		}
+		if _, ok := skus[review.SkuID]; ok {
+			return nil, v1.ErrorParamInvalid("商品:%d重复评价", review.SkuID)
+		}
+		skus[review.SkuID] = struct{}{}
+	}
+	existing, err := uc.repo.GetReviewByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range existing {
+		if _, ok := skus[r.SkuID]; ok {
+			return nil, v1.ErrorOrderReviewed("订单:%d的商品:%d已评价", orderID, r.SkuID)
+		}
+	}
+	if err := uc.fillOrderInfo(ctx, reviews...); err != nil {
+		return nil, err
+	}
+	// 同一批的评价一起保存，保存前要在批内检测重复内容
+	var batch []*Fingerprint
+	for _, review := range reviews {
+		review.ReviewID = snowflake.GenID()
+		review.Status = ReviewStatusMachine.Initial()
+		ctrl := &CtrlInfo{}
+		if err := uc.moderateReview(review, ctrl); err != nil {
+			return nil, err
+		}
+		uc.checkSpam(ctx, review, len(reviews), batch, ctrl)
+		review.CtrlJSON = ctrl.JSON()
+		if hash, ok := SimHash(review.Content); ok {
+			batch = append(batch, &Fingerprint{ReviewID: review.ReviewID, Hash: hash})
+		}
+	}
+	if err := uc.repo.SaveReviews(ctx, reviews); err != nil {
+		return nil, err
+	}
+	uc.spam.Record(ctx, reviews...)
+	return reviews, nil
+}
+
+// OrderReviews 订单的评价情况
+type OrderReviews struct {
+	Reviews          []*model.ReviewInfo // 订单中已经评价的商品的评价（不包括已删除的）
+	UnreviewedSkuIDs []int64             // 订单中还没有评价的商品
+}
+
+// GetReviewByOrderID 查询订单的评价情况，skuIDs是订单中的全部商品
+// 已删除的评价不返回，但是对应的商品也算已经评价过
+func (uc *ReviewUsecase) GetReviewByOrderID(ctx context.Context, orderID int64, skuIDs []int64) (*OrderReviews, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] GetReviewByOrderID orderID:%d skuIDs:%v", orderID, skuIDs)
+	reviews, err := uc.repo.GetReviewByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	ret := &OrderReviews{Reviews: make([]*model.ReviewInfo, 0, len(reviews))}
+	reviewed := make(map[int64]struct{}, len(reviews))
+	for _, review := range reviews {
+		reviewed[review.SkuID] = struct{}{}
+		if review.DeleteAt.Valid {
+			continue
+		}
+		review.OpRemarks = ""
+		review.OpUser = ""
+		if review.Status != ReviewStatusRejected {
+			review.OpReason = ""
+		}
+		ret.Reviews = append(ret.Reviews, review)
+	}
+	for _, skuID := range skuIDs {
+		if _, ok := reviewed[skuID]; !ok {
+			ret.UnreviewedSkuIDs = append(ret.UnreviewedSkuIDs, skuID)
+		}
+	}
+	return ret, nil
+}
+
+// ReviewDetail 评价详情
+type ReviewDetail struct {
+	Review *model.ReviewInfo
+	Reply  *model.ReviewReplyInfo  // 商家还没有回复时为nil
+	Appeal *model.ReviewAppealInfo // 没有申诉或者调用方无权查看时为nil
+	Append *model.ReviewAppendInfo // 没有追评或者追评还没有审核通过（C端查看）时为nil
+	// ShowOpReason 调用方可以查看审核原因：运营，或者作者查看自己审核不通过的评价
+	ShowOpReason bool
+}
+
+// GetReview 查询评价详情，包括商家回复以及申诉信息（仅商家和运营可见）
+// 调用方身份从ctx中获取，C端只能查看审核通过的评价，作者本人可以查看自己任意状态的评价
+// 每张表最多查询一次
+func (uc *ReviewUsecase) GetReview(ctx context.Context, param *GetReviewParam) (*ReviewDetail, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] GetReview param:%v", param)
+	review, err := uc.repo.GetReview(ctx, param.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+	caller := CallerFromContext(ctx)
+	isMerchant := caller.IsMerchantOf(review.StoreID)
+	isOperator := caller.IsOperator()
+	isAuthor := caller.IsUser(review.UserID)
+	if !isMerchant && !isOperator && !isAuthor && review.Status != ReviewStatusApproved {
+		// 不暴露评价是否存在
+		return nil, v1.ErrorReviewNotFound("评价:%d不存在", review.ReviewID)
+	}
+	detail := &ReviewDetail{
+		Review:       review,
+		ShowOpReason: isOperator || (isAuthor && review.Status == ReviewStatusRejected),
+	}
+	if review.HasReply == ReplyStateReplied {
+		replies, err := uc.repo.ListReplyByReviewIDs(ctx, []int64{review.ReviewID})
+		if err != nil {
+			return nil, err
+		}
+		if len(replies) > 0 {
+			detail.Reply = replies[0]
+		}
+	}
+	if isMerchant || isOperator {
+		if detail.Appeal, err = uc.repo.GetAppealByReviewID(ctx, review.ReviewID); err != nil {
+			return nil, err
+		}
+	}
+	if detail.Append, err = uc.repo.GetAppendByReviewID(ctx, review.ReviewID); err != nil {
+		return nil, err
+	}
+	if !detail.ShowOpReason {
+		review.OpReason = ""
+	}
+	// C端查看时匿名评价隐藏用户身份，运营的审核信息也不对外展示
+	if !isMerchant && !isOperator {
+		maskReview(review)
+		if detail.Append != nil && detail.Append.Status != AppendStatusApproved {
+			detail.Append = nil
+		}
+		if detail.Append != nil {
+			detail.Append.OpUser = ""
+			detail.Append.OpRemarks = ""
+		}
+	}
+	return detail, nil
+}
+
+// maskReview C端查看评价时隐藏匿名评价的用户身份和运营的审核信息
+func maskReview(review *model.ReviewInfo) {
+	if review.Anonymous == 1 {
+		review.UserID = 0
+	}
+	review.OpUser = ""
+	review.OpRemarks = ""
 }
 
-// GetReview
-func (uc *ReviewUsecase) GetReview(ctx context.Context, reviewID int64) (*model.ReviewInfo, error) {
-	uc.log.WithContext(ctx).Debugf("[biz] GetReview reviewID:%v", reviewID)
-	return uc.repo.GetReview(ctx, reviewID)
+// MaxBatchSize 批量查询一次最多能查的ID个数
+const MaxBatchSize = 100
+
+// BatchGetReviews 根据评价ID批量查询评价，返回 评价ID -> 评价，查不到或者调用方无权查看的ID不在map中
+func (uc *ReviewUsecase) BatchGetReviews(ctx context.Context, reviewIDs []int64) (map[int64]*model.ReviewInfo, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] BatchGetReviews reviewIDs:%v", reviewIDs)
+	ids, err := uniqueIDs(reviewIDs)
+	if err != nil {
+		return nil, err
+	}
+	reviews, err := uc.repo.ListReviewByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	caller := CallerFromContext(ctx)
+	ret := make(map[int64]*model.ReviewInfo, len(reviews))
+	for _, review := range reviews {
+		if visibleReview(caller, review) {
+			ret[review.ReviewID] = review
+		}
+	}
+	return ret, nil
+}
+
+// BatchGetReviewsByOrderIDs 根据订单ID批量查询评价，返回 订单ID -> 该订单的评价，没有评价的订单不在map中
+func (uc *ReviewUsecase) BatchGetReviewsByOrderIDs(ctx context.Context, orderIDs []int64) (map[int64][]*model.ReviewInfo, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] BatchGetReviewsByOrderIDs orderIDs:%v", orderIDs)
+	ids, err := uniqueIDs(orderIDs)
+	if err != nil {
+		return nil, err
+	}
+	reviews, err := uc.repo.ListReviewByOrderIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	caller := CallerFromContext(ctx)
+	ret := make(map[int64][]*model.ReviewInfo, len(ids))
+	for _, review := range reviews {
+		if visibleReview(caller, review) {
+			ret[review.OrderID] = append(ret[review.OrderID], review)
+		}
+	}
+	return ret, nil
+}
+
+// visibleReview 批量查询时按调用方过滤评价，规则和GetReview一致：
+// 商家只能看到自己店铺的全部评价，C端只能看到审核通过的评价和自己的评价，C端看到的评价会隐藏匿名用户和审核信息
+func visibleReview(caller *Caller, review *model.ReviewInfo) bool {
+	if caller.IsOperator() || caller.IsMerchantOf(review.StoreID) {
+		return true
+	}
+	if review.Status != ReviewStatusApproved && !caller.IsUser(review.UserID) {
+		return false
+	}
+	maskReview(review)
+	return true
+}
+
+// uniqueIDs 批量查询的ID去重并校验个数
+func uniqueIDs(ids []int64) ([]int64, error) {
+	seen := make(map[int64]struct{}, len(ids))
+	ret := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ret = append(ret, id)
+	}
+	if len(ret) == 0 || len(ret) > MaxBatchSize {
+		return nil, v1.ErrorParamInvalid("批量查询的ID个数必须在1到%d之间", MaxBatchSize)
+	}
+	return ret, nil
 }
 
 // CreateReply 创建回复
@@ -82,37 +399,515 @@ func (uc *ReviewUsecase) CreateReply(ctx context.Context, param *ReplyParam) (*m
 		PicInfo:   param.PicInfo,
 		VideoInfo: param.VideoInfo,
 	}
-	return uc.repo.SaveReply(ctx, reply)
+	if err := uc.moderateReply(reply); err != nil {
+		return nil, err
+	}
+	return uc.repo.SaveReply(ctx, reply, param.Auto)
+}
+
+// UpdateReply 商家修改回复，修改后的内容重新做敏感词审核
+func (uc *ReviewUsecase) UpdateReply(ctx context.Context, param *UpdateReplyParam) (*model.ReviewReplyInfo, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] UpdateReply param:%v", param)
+	if param.Content == "" {
+		return nil, v1.ErrorParamInvalid("回复内容不能为空")
+	}
+	reply, err := uc.checkReplyEditable(ctx, param.ReplyID, param.StoreID)
+	if err != nil {
+		return nil, err
+	}
+	updated := &model.ReviewReplyInfo{
+		ReplyID:   reply.ReplyID,
+		ReviewID:  reply.ReviewID,
+		StoreID:   reply.StoreID,
+		Content:   param.Content,
+		PicInfo:   param.PicInfo,
+		VideoInfo: param.VideoInfo,
+	}
+	if err := uc.moderateReply(updated); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.UpdateReply(ctx, reply, updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// DeleteReply 商家撤回回复，撤回后评价变回未回复，商家可以重新回复
+func (uc *ReviewUsecase) DeleteReply(ctx context.Context, param *DeleteReplyParam) error {
+	uc.log.WithContext(ctx).Debugf("[biz] DeleteReply param:%v", param)
+	reply, err := uc.checkReplyEditable(ctx, param.ReplyID, param.StoreID)
+	if err != nil {
+		return err
+	}
+	return uc.repo.DeleteReply(ctx, reply)
+}
+
+// checkReplyEditable 校验回复属于该店铺并且还在允许修改的时间窗口内
+func (uc *ReviewUsecase) checkReplyEditable(ctx context.Context, replyID, storeID int64) (*model.ReviewReplyInfo, error) {
+	reply, err := uc.repo.GetReply(ctx, replyID)
+	if err != nil {
+		return nil, err
+	}
+	// 水平越权校验：只能操作自己店铺的回复
+	if reply.StoreID != storeID {
+		return nil, v1.ErrorStoreForbidden("店铺:%d无权操作回复:%d", storeID, replyID)
+	}
+	if time.Since(reply.CreateAt) > uc.replyEditWindow {
+		return nil, v1.ErrorReplyNotEditable("回复:%d已超过%v，不能修改或撤回", replyID, uc.replyEditWindow)
+	}
+	return reply, nil
+}
+
+// ListReplyByStoreID 商家后台分页查询店铺的回复
+func (uc *ReviewUsecase) ListReplyByStoreID(ctx context.Context, storeID int64, page, size int) ([]*model.ReviewReplyInfo, int64, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListReplyByStoreID storeID:%d", storeID)
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 || size > 50 {
+		size = 10
+	}
+	return uc.repo.ListReplyByStoreID(ctx, storeID, (page-1)*size, size)
+}
+
+// AuditReview 审核评价（运营对用户的评价进行审核，只有待审核的评价才能审核）
+func (uc *ReviewUsecase) AuditReview(ctx context.Context, param *AuditParam) error {
+	uc.log.WithContext(ctx).Debugf("[biz] AuditReview param:%v", param)
+	// 审核结果只能是通过或者不通过
+	if param.Status != ReviewStatusApproved && param.Status != ReviewStatusRejected {
+		return v1.ErrorParamInvalid("无效的审核状态:%d", param.Status)
+	}
+	// 审核人从调用方身份获取，只有领取了审核任务的人才能审核
+	opUser, err := operatorFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	param.OpUser = opUser
+	if err := uc.audit.CheckLease(ctx, AuditTaskReview, param.ReviewID, param.OpUser); err != nil {
+		return err
+	}
+	review, err := uc.repo.GetReview(ctx, param.ReviewID)
+	if err != nil {
+		return err
+	}
+	if err := ReviewStatusMachine.Transit(review.Status, param.Status); err != nil {
+		return err
+	}
+	if err := uc.repo.AuditReview(ctx, param); err != nil {
+		return err
+	}
+	uc.audit.Release(ctx, AuditTaskReview, param.ReviewID, param.OpUser)
+	return nil
+}
+
+// UpdateReview 用户修改评价
+// 只有作者本人在发布后的时间窗口内、商家还没有回复时才能修改
+// 修改后的内容和新发布的评价一样经过敏感词审核和刷评检测，返回修改后的评价状态
+func (uc *ReviewUsecase) UpdateReview(ctx context.Context, param *UpdateReviewParam) (int32, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] UpdateReview param:%v", param)
+	if param.Score < 1 || param.Score > 5 ||
+		param.ServiceScore < 0 || param.ServiceScore > 5 ||
+		param.ExpressScore < 0 || param.ExpressScore > 5 {
+		return 0, v1.ErrorParamInvalid("评分必须在1到5之间")
+	}
+	if param.Content == "" {
+		return 0, v1.ErrorParamInvalid("评价内容不能为空")
+	}
+	review, err := uc.repo.GetReview(ctx, param.ReviewID)
+	if err != nil {
+		return 0, err
+	}
+	if review.UserID != param.UserID {
+		return 0, v1.ErrorUserForbidden("用户:%d无权操作评价:%d", param.UserID, review.ReviewID)
+	}
+	if review.HasReply == ReplyStateReplied {
+		return 0, v1.ErrorReviewNotEditable("评价:%d商家已回复，不能修改", review.ReviewID)
+	}
+	if time.Since(review.CreateAt) > uc.editWindow {
+		return 0, v1.ErrorReviewNotEditable("评价:%d发布已超过%v，不能修改", review.ReviewID, uc.editWindow)
+	}
+	if err := ReviewStatusMachine.Transit(review.Status, ReviewStatusPending); err != nil {
+		return 0, err
+	}
+	edited := *review
+	edited.Content = param.Content
+	edited.Status = ReviewStatusPending
+	ctrl := &CtrlInfo{}
+	if err := uc.moderateReview(&edited, ctrl); err != nil {
+		return 0, err
+	}
+	// 修改不算新发布的评价，不占用发布频率
+	uc.checkSpam(ctx, &edited, 0, nil, ctrl)
+	param.Content = edited.Content
+	param.Status = edited.Status
+	param.CtrlJSON = ctrl.JSON()
+	if err := uc.repo.UpdateReview(ctx, review, param); err != nil {
+		return 0, err
+	}
+	uc.spam.RecordFingerprint(ctx, &edited)
+	return param.Status, nil
+}
+
+// ListReviewHistory 查询评价的修改历史
+func (uc *ReviewUsecase) ListReviewHistory(ctx context.Context, reviewID int64) ([]*model.ReviewHistory, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListReviewHistory reviewID:%d", reviewID)
+	return uc.repo.ListReviewHistory(ctx, reviewID)
+}
+
+// AppendReview 用户追评
+// 只有作者本人能对审核通过的评价追评，每条评价只能追评一次，追评需要单独审核
+func (uc *ReviewUsecase) AppendReview(ctx context.Context, param *AppendParam) (*model.ReviewAppendInfo, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] AppendReview param:%v", param)
+	if param.Content == "" {
+		return nil, v1.ErrorParamInvalid("追评内容不能为空")
+	}
+	review, err := uc.repo.GetReview(ctx, param.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review.UserID != param.UserID {
+		return nil, v1.ErrorUserForbidden("用户:%d无权操作评价:%d", param.UserID, review.ReviewID)
+	}
+	if review.Status != ReviewStatusApproved {
+		return nil, v1.ErrorReviewStatusInvalid("评价:%d审核通过后才能追评", review.ReviewID)
+	}
+	existing, err := uc.repo.GetAppendByReviewID(ctx, review.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, v1.ErrorAppendExists("评价:%d已追评", review.ReviewID)
+	}
+	return uc.repo.SaveAppend(ctx, &model.ReviewAppendInfo{
+		AppendID:  snowflake.GenID(),
+		ReviewID:  review.ReviewID,
+		UserID:    review.UserID,
+		StoreID:   review.StoreID,
+		Content:   param.Content,
+		PicInfo:   param.PicInfo,
+		VideoInfo: param.VideoInfo,
+		Status:    AppendStatusMachine.Initial(),
+	})
+}
+
+// AuditAppend 运营审核追评
+func (uc *ReviewUsecase) AuditAppend(ctx context.Context, param *AuditAppendParam) error {
+	uc.log.WithContext(ctx).Debugf("[biz] AuditAppend param:%v", param)
+	if param.Status != AppendStatusApproved && param.Status != AppendStatusRejected {
+		return v1.ErrorParamInvalid("无效的审核状态:%d", param.Status)
+	}
+	opUser, err := operatorFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	param.OpUser = opUser
+	review, err := uc.repo.GetReview(ctx, param.ReviewID)
+	if err != nil {
+		return err
+	}
+	appendInfo, err := uc.repo.GetAppendByReviewID(ctx, param.ReviewID)
+	if err != nil {
+		return err
+	}
+	if appendInfo == nil {
+		return v1.ErrorAppendNotFound("评价:%d没有追评", param.ReviewID)
+	}
+	if err := AppendStatusMachine.Transit(appendInfo.Status, param.Status); err != nil {
+		return err
+	}
+	return uc.repo.AuditAppend(ctx, review, appendInfo, param)
+}
+
+// DeleteReview 删除评价（逻辑删除）
+func (uc *ReviewUsecase) DeleteReview(ctx context.Context, param *DeleteReviewParam) error {
+	uc.log.WithContext(ctx).Debugf("[biz] DeleteReview param:%v", param)
+	review, err := uc.repo.GetReview(ctx, param.ReviewID)
+	if err != nil {
+		return err
+	}
+	if err := checkReviewOwner(ctx, review, param); err != nil {
+		return err
+	}
+	return uc.repo.DeleteReview(ctx, review, param)
+}
+
+// RestoreReview 恢复已删除的评价
+func (uc *ReviewUsecase) RestoreReview(ctx context.Context, param *DeleteReviewParam) error {
+	uc.log.WithContext(ctx).Debugf("[biz] RestoreReview param:%v", param)
+	review, err := uc.repo.GetDeletedReview(ctx, param.ReviewID)
+	if err != nil {
+		return err
+	}
+	if err := checkReviewOwner(ctx, review, param); err != nil {
+		return err
+	}
+	return uc.repo.RestoreReview(ctx, review, param)
+}
+
+// checkReviewOwner 水平越权校验，用户只能操作自己的评价，运营不限制
+// 操作人从ctx中的调用方身份获取，不信任请求参数，校验通过后填到param中用于记录操作人
+func checkReviewOwner(ctx context.Context, review *model.ReviewInfo, param *DeleteReviewParam) error {
+	caller := CallerFromContext(ctx)
+	switch {
+	case caller.IsOperator():
+		param.UserID, param.OpUser = 0, caller.OpUser
+	case caller.IsUser(review.UserID):
+		param.UserID, param.OpUser = caller.UserID, ""
+	default:
+		return v1.ErrorUserForbidden("用户:%d无权操作评价:%d", caller.UserID, review.ReviewID)
+	}
+	return nil
 }
 
 // AppealReview 申述评价
 func (uc *ReviewUsecase) AppealReview(ctx context.Context, param *AppealParam) (*model.ReviewAppealInfo, error) {
 	uc.log.WithContext(ctx).Debugf("[biz] AppealReview param :%v", param)
+	// 申诉原因必须是配置中的类别，并且提供了该原因要求的材料
+	if err := uc.reasons.Validate(param); err != nil {
+		return nil, err
+	}
+	// 申诉通过后评价会被隐藏，所以只有能被隐藏的评价才能申诉
+	review, err := uc.repo.GetReview(ctx, param.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ReviewStatusMachine.Transit(review.Status, ReviewStatusHidden); err != nil {
+		return nil, err
+	}
+	// 水平越权校验：只能申诉自己店铺的评价
+	if review.StoreID != param.StoreID {
+		return nil, v1.ErrorStoreForbidden("店铺:%d无权操作评价:%d", param.StoreID, param.ReviewID)
+	}
+	if uc.appealWindow > 0 && time.Since(review.CreateAt) > uc.appealWindow {
+		return nil, v1.ErrorAppealExpired("评价:%d已超过申诉期限", param.ReviewID)
+	}
+	// 最近一次申诉待审核时重新提交会撤回原申诉，已撤回的可以再次申诉，通过或驳回后不能再申诉
+	appeal, err := uc.repo.GetAppealByReviewID(ctx, param.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+	if appeal != nil && appeal.Status != AppealStatusWithdrawn {
+		if err := AppealStatusMachine.Transit(appeal.Status, AppealStatusWithdrawn); err != nil {
+			return nil, err
+		}
+	}
+	uc.moderateAppeal(param)
 	return uc.repo.AppealReview(ctx, param)
 }
 
-// AduitAppeal 审核申述
-func (uc ReviewUsecase) AuditAppeal(ctx context.Context, param *AuditAppealParam) error {
+// AduitAppeal 审核申述，审核完成后通知商家审核结果
+func (uc *ReviewUsecase) AuditAppeal(ctx context.Context, param *AuditAppealParam) error {
 	uc.log.WithContext(ctx).Debugf("[biz] AuditAppeal param:%v", param)
-	return uc.repo.AuditAppeal(ctx, param)
+	// 审核结果只能是通过或者驳回
+	if param.Status != AppealStatusApproved && param.Status != AppealStatusRejected {
+		return v1.ErrorParamInvalid("无效的审核状态:%d", param.Status)
+	}
+	// 驳回的原因会通知给商家，不能为空
+	param.OpReason = strings.TrimSpace(param.OpReason)
+	if param.Status == AppealStatusRejected && param.OpReason == "" {
+		return v1.ErrorParamInvalid("驳回申诉必须填写原因")
+	}
+	// 审核人从调用方身份获取，只有领取了审核任务的人才能审核
+	opUser, err := operatorFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	param.OpUser = opUser
+	if err := uc.audit.CheckLease(ctx, AuditTaskAppeal, param.AppealID, param.OpUser); err != nil {
+		return err
+	}
+	appeal, err := uc.repo.GetAppeal(ctx, param.AppealID)
+	if err != nil {
+		return err
+	}
+	if appeal.ReviewID != param.ReviewID {
+		return v1.ErrorParamInvalid("申诉:%d不属于评价:%d", param.AppealID, param.ReviewID)
+	}
+	if err := AppealStatusMachine.Transit(appeal.Status, param.Status); err != nil {
+		return err
+	}
+	// 申诉通过需要隐藏评价
+	if param.Status == AppealStatusApproved {
+		review, err := uc.repo.GetReview(ctx, param.ReviewID)
+		if err != nil {
+			return err
+		}
+		if err := ReviewStatusMachine.Transit(review.Status, ReviewStatusHidden); err != nil {
+			return err
+		}
+	}
+	// 通知商家审核结果，事件由EventRelay从发件箱发送
+	now := time.Now()
+	event := &Event{
+		Type: EventAppealAudited,
+		Key:  appeal.StoreID,
+		Payload: &AppealAuditedPayload{
+			AppealID: appeal.AppealID,
+			ReviewID: appeal.ReviewID,
+			StoreID:  appeal.StoreID,
+			Status:   param.Status,
+			OpReason: param.OpReason,
+			OpUser:   param.OpUser,
+			AuditAt:  now.Unix(),
+		},
+		CreateAt: now,
+	}
+	if err := uc.repo.AuditAppeal(ctx, param, event); err != nil {
+		return err
+	}
+	uc.audit.Release(ctx, AuditTaskAppeal, param.AppealID, param.OpUser)
+	return nil
 }
 
-//ListReviewByStoreID 根据StoreID查询评价
+// ListReviewByStoreID 根据storeID分页查询评价，支持筛选和排序，同时返回符合条件的总数
+func (uc ReviewUsecase) ListReviewByStoreID(ctx context.Context, param *ListReviewParam) ([]*MyReviewInfo, int64, error) {
+	if param.Page <= 0 {
+		param.Page = 1
+	}
+	if param.Size <= 0 || param.Size > 50 {
+		param.Size = 10
+	}
+	if param.MinScore > 0 && param.MaxScore > 0 && param.MinScore > param.MaxScore {
+		return nil, 0, v1.ErrorParamInvalid("无效的评分范围:%d-%d", param.MinScore, param.MaxScore)
+	}
+	if !param.StartTime.IsZero() && !param.EndTime.IsZero() && param.StartTime.After(param.EndTime) {
+		return nil, 0, v1.ErrorParamInvalid("无效的时间范围")
+	}
+	offset := (param.Page - 1) * param.Size
+	limit := param.Size
+	uc.log.WithContext(ctx).Debugf("[biz] ListReviewByStoreID param:%v", param)
+
+	return uc.repo.ListReviewByStoreID(ctx, param, offset, limit)
+}
 
-// ListReviewByStoreID 根据storeID分页查询评价
-func (uc ReviewUsecase) ListReviewByStoreID(ctx context.Context, storeID int64, page, size int) ([]*MyReviewInfo, error) {
+// ListReviewBySpu 商品详情页根据spu/sku查询评价
+// 只返回审核通过的评价，匿名评价不返回用户信息
+func (uc ReviewUsecase) ListReviewBySpu(ctx context.Context, param *ListSpuReviewParam) (*ReviewPage, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListReviewBySpu param:%v", param)
+	if param.SpuID <= 0 && param.SkuID <= 0 {
+		return nil, v1.ErrorParamInvalid("spuID和skuID不能同时为空")
+	}
+	if param.Size <= 0 || param.Size > 50 {
+		param.Size = 10
+	}
+	approved := ReviewStatusApproved
+	query := &ListReviewParam{
+		SpuID:  param.SpuID,
+		SkuID:  param.SkuID,
+		Status: &approved,
+		Sort:   ReviewSortNewest,
+	}
+	if param.OnlyMedia {
+		hasMedia := int32(1)
+		query.HasMedia = &hasMedia
+	}
+	if param.OnlyContent {
+		hasContent := int32(1)
+		query.HasContent = &hasContent
+	}
+	switch param.ScoreLevel {
+	case ScoreLevelAll:
+	case ScoreLevelGood:
+		query.MinScore, query.MaxScore = 4, 5
+	case ScoreLevelNeutral:
+		query.MinScore, query.MaxScore = 3, 3
+	case ScoreLevelBad:
+		query.MinScore, query.MaxScore = 1, 2
+	default:
+		return nil, v1.ErrorParamInvalid("无效的评分档位:%d", param.ScoreLevel)
+	}
+	page, err := uc.repo.ListReviewBySpu(ctx, query, param.Cursor, param.Size)
+	if err != nil {
+		return nil, err
+	}
+	for _, review := range page.List {
+		maskAnonymous(review)
+	}
+	return page, nil
+}
+
+// UserReview 用户自己的评价，带上商家回复
+type UserReview struct {
+	*model.ReviewInfo
+	Reply  *model.ReviewReplyInfo  // 商家还没有回复时为nil
+	Append *model.ReviewAppendInfo // 没有追评时为nil
+}
+
+// ListReviewByUserID 用户中心查询自己的评价
+// 包括待审核和审核不通过的评价（只有作者本人能看到），审核不通过的评价返回拒绝原因
+func (uc ReviewUsecase) ListReviewByUserID(ctx context.Context, userID int64, page, size int) ([]*UserReview, int64, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListReviewByUserID userID:%v", userID)
 	if page <= 0 {
 		page = 1
 	}
 	if size <= 0 || size > 50 {
 		size = 10
 	}
-	offset := (page - 1) * size
-	limit := size
-	uc.log.WithContext(ctx).Debugf("[biz] ListReviewByStoreID storeID:%v", storeID)
-
-	return uc.repo.ListReviewByStoreID(ctx, storeID, offset, limit)
+	reviews, total, err := uc.repo.ListReviewByUserID(ctx, userID, (page-1)*size, size)
+	if err != nil {
+		return nil, 0, err
+	}
+	// 一次查询把这一页评价的商家回复都查出来
+	reviewIDs := make([]int64, 0, len(reviews))
+	for _, review := range reviews {
+		if review.HasReply == ReplyStateReplied {
+			reviewIDs = append(reviewIDs, review.ReviewID)
+		}
+	}
+	replies, err := uc.repo.ListReplyByReviewIDs(ctx, reviewIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+	replyMap := make(map[int64]*model.ReviewReplyInfo, len(replies))
+	for _, reply := range replies {
+		replyMap[reply.ReviewID] = reply
+	}
+	// 追评同样一次查出来，用户能看到自己所有状态的追评
+	appendMap := make(map[int64]*model.ReviewAppendInfo)
+	if len(reviews) > 0 {
+		allIDs := make([]int64, 0, len(reviews))
+		for _, review := range reviews {
+			allIDs = append(allIDs, review.ReviewID)
+		}
+		appends, err := uc.repo.ListAppendByReviewIDs(ctx, allIDs)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, a := range appends {
+			a.OpRemarks = ""
+			a.OpUser = ""
+			if a.Status != AppendStatusRejected {
+				a.OpReason = ""
+			}
+			appendMap[a.ReviewID] = a
+		}
+	}
+	list := make([]*UserReview, 0, len(reviews))
+	for _, review := range reviews {
+		// 运营的备注等信息不对用户展示，拒绝原因只在审核不通过时展示
+		review.OpRemarks = ""
+		review.OpUser = ""
+		if review.Status != ReviewStatusRejected {
+			review.OpReason = ""
+		}
+		list = append(list, &UserReview{
+			ReviewInfo: review,
+			Reply:      replyMap[review.ReviewID],
+			Append:     appendMap[review.ReviewID],
+		})
+	}
+	return list, total, nil
+}
 
+// maskAnonymous 匿名评价隐藏用户身份
+func maskAnonymous(review *MyReviewInfo) {
+	if review.Anonymous != 1 {
+		return
+	}
+	review.UserID = 0
+	if review.ReviewInfo != nil {
+		review.ReviewInfo.UserID = 0
+	}
 }
 
 //biz层创建MyReviewInfo防止循环引用
@@ -141,10 +936,26 @@ type MyReviewInfo struct {
 	SpuID        int64 `json:"spu_id,string"`
 	StoreID      int64 `json:"store_id,string"`
 	UserID       int64 `json:"user_id,string"`
+
+	Append *AppendDoc `json:"append"` // 审核通过的追评，由追评审核通过时写入ES
+}
+
+// AppendDoc ES评价文档中的追评，字段格式和评价保持一致
+type AppendDoc struct {
+	AppendID  int64  `json:"append_id,string"`
+	Content   string `json:"content"`
+	PicInfo   string `json:"pic_info"`
+	VideoInfo string `json:"video_info"`
+	CreateAt  MyTime `json:"create_at"`
 }
 
 type MyTime time.Time
 
+// MarshalJSON 按照ES中的时间格式序列化
+func (t MyTime) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + time.Time(t).Format(time.DateTime) + `"`), nil
+}
+
 // UnmarshalJSON json.Unmarshal 的时候会自动调用这个方法
 func (t *MyTime) UnmarshalJSON(data []byte) error {
 	// data = "\"2023-12-17 14:20:18\""
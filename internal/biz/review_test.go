@@ -0,0 +1,184 @@
+package biz
+
+import (
+	"context"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/data/model"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// memoryReviewRepo 测试用的评价repo，只实现了测试用到的方法，调用其他方法会panic
+type memoryReviewRepo struct {
+	ReviewRepo
+	reviews map[int64]*model.ReviewInfo
+	deleted map[int64]*model.ReviewInfo
+
+	lastDelete *DeleteReviewParam
+	lastAudit  *AuditParam
+	saved      []*model.ReviewInfo
+	replies    []*model.ReviewReplyInfo
+	replyErr   map[int64]error // 回复这些评价时SaveReply返回的错误
+}
+
+func (r *memoryReviewRepo) GetReview(ctx context.Context, reviewID int64) (*model.ReviewInfo, error) {
+	if review, ok := r.reviews[reviewID]; ok {
+		return review, nil
+	}
+	return nil, v1.ErrorReviewNotFound("评价:%d不存在", reviewID)
+}
+
+func (r *memoryReviewRepo) GetDeletedReview(ctx context.Context, reviewID int64) (*model.ReviewInfo, error) {
+	if review, ok := r.deleted[reviewID]; ok {
+		return review, nil
+	}
+	return nil, v1.ErrorReviewNotFound("评价:%d不存在", reviewID)
+}
+
+func (r *memoryReviewRepo) DeleteReview(ctx context.Context, review *model.ReviewInfo, param *DeleteReviewParam) error {
+	r.lastDelete = param
+	return nil
+}
+
+func (r *memoryReviewRepo) SaveReviews(ctx context.Context, reviews []*model.ReviewInfo) error {
+	r.saved = append(r.saved, reviews...)
+	return nil
+}
+
+// ListReviewByOrderIDsWithDeleted 查询订单的评价，deleted中的评价是已删除的评价
+func (r *memoryReviewRepo) ListReviewByOrderIDsWithDeleted(ctx context.Context, orderIDs []int64) ([]*model.ReviewInfo, error) {
+	ids := make(map[int64]struct{}, len(orderIDs))
+	for _, id := range orderIDs {
+		ids[id] = struct{}{}
+	}
+	var ret []*model.ReviewInfo
+	for _, m := range []map[int64]*model.ReviewInfo{r.reviews, r.deleted} {
+		for _, review := range m {
+			if _, ok := ids[review.OrderID]; ok {
+				ret = append(ret, review)
+			}
+		}
+	}
+	return ret, nil
+}
+
+func (r *memoryReviewRepo) SaveReply(ctx context.Context, reply *model.ReviewReplyInfo, auto bool) (*model.ReviewReplyInfo, error) {
+	if err := r.replyErr[reply.ReviewID]; err != nil {
+		return nil, err
+	}
+	r.replies = append(r.replies, reply)
+	return reply, nil
+}
+
+func (r *memoryReviewRepo) AuditReview(ctx context.Context, param *AuditParam) error {
+	r.lastAudit = param
+	return nil
+}
+
+func (r *memoryReviewRepo) RestoreReview(ctx context.Context, review *model.ReviewInfo, param *DeleteReviewParam) error {
+	r.lastDelete = param
+	return nil
+}
+
+func TestVisibleReview(t *testing.T) {
+	newReview := func(status, anonymous int32) *model.ReviewInfo {
+		return &model.ReviewInfo{
+			ReviewID:  1,
+			StoreID:   100,
+			UserID:    200,
+			Status:    status,
+			Anonymous: anonymous,
+			OpUser:    "op",
+			OpRemarks: "remarks",
+		}
+	}
+	tests := []struct {
+		name       string
+		caller     *Caller
+		review     *model.ReviewInfo
+		wantOK     bool
+		wantUserID int64
+		wantOpUser string
+	}{
+		{"C端看审核通过的评价", &Caller{Role: RoleUser}, newReview(ReviewStatusApproved, 0), true, 200, ""},
+		{"C端看匿名评价隐藏用户", &Caller{Role: RoleUser, UserID: 300}, newReview(ReviewStatusApproved, 1), true, 0, ""},
+		{"C端看不到待审核评价", &Caller{Role: RoleUser, UserID: 300}, newReview(ReviewStatusPending, 0), false, 0, ""},
+		{"C端看不到隐藏评价", &Caller{Role: RoleUser}, newReview(ReviewStatusHidden, 0), false, 0, ""},
+		{"作者看自己审核不通过的评价", &Caller{Role: RoleUser, UserID: 200}, newReview(ReviewStatusRejected, 0), true, 200, ""},
+		{"商家看自己店铺的待审核评价", &Caller{Role: RoleMerchant, StoreID: 100}, newReview(ReviewStatusPending, 1), true, 200, "op"},
+		{"商家看不到其他店铺的待审核评价", &Caller{Role: RoleMerchant, StoreID: 101}, newReview(ReviewStatusPending, 0), false, 0, ""},
+		{"商家看其他店铺的匿名评价隐藏用户", &Caller{Role: RoleMerchant, StoreID: 101}, newReview(ReviewStatusApproved, 1), true, 0, ""},
+		{"运营看全部评价", &Caller{Role: RoleOperator, OpUser: "admin"}, newReview(ReviewStatusHidden, 1), true, 200, "op"},
+		{"运营账号为空按C端处理", &Caller{Role: RoleOperator}, newReview(ReviewStatusPending, 0), false, 0, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok := visibleReview(tt.caller, tt.review)
+			if ok != tt.wantOK {
+				t.Fatalf("visibleReview() = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if tt.review.UserID != tt.wantUserID || tt.review.OpUser != tt.wantOpUser {
+				t.Fatalf("visibleReview() review userID:%d opUser:%q, want userID:%d opUser:%q",
+					tt.review.UserID, tt.review.OpUser, tt.wantUserID, tt.wantOpUser)
+			}
+		})
+	}
+}
+
+func TestDeleteReviewOwner(t *testing.T) {
+	tests := []struct {
+		name       string
+		caller     *Caller
+		param      *DeleteReviewParam
+		wantErr    func(error) bool
+		wantUserID int64
+		wantOpUser string
+	}{
+		{"作者删除自己的评价", &Caller{Role: RoleUser, UserID: 200}, &DeleteReviewParam{ReviewID: 1}, nil, 200, ""},
+		{"运营删除任意评价", &Caller{Role: RoleOperator, OpUser: "admin"}, &DeleteReviewParam{ReviewID: 1}, nil, 0, "admin"},
+		{"用户在请求中带上opUser删除别人的评价", &Caller{Role: RoleUser, UserID: 300}, &DeleteReviewParam{ReviewID: 1, OpUser: "admin"}, v1.IsUserForbidden, 0, ""},
+		{"用户在请求中冒充作者", &Caller{Role: RoleUser, UserID: 300}, &DeleteReviewParam{ReviewID: 1, UserID: 200}, v1.IsUserForbidden, 0, ""},
+		{"未登录", &Caller{Role: RoleUser}, &DeleteReviewParam{ReviewID: 1, OpUser: "admin"}, v1.IsUserForbidden, 0, ""},
+		{"运营账号为空", &Caller{Role: RoleOperator}, &DeleteReviewParam{ReviewID: 1, OpUser: "admin"}, v1.IsUserForbidden, 0, ""},
+		{"商家不能删除评价", &Caller{Role: RoleMerchant, StoreID: 100}, &DeleteReviewParam{ReviewID: 1}, v1.IsUserForbidden, 0, ""},
+	}
+	for _, tt := range tests {
+		for _, restore := range []bool{false, true} {
+			t.Run(tt.name, func(t *testing.T) {
+				review := &model.ReviewInfo{ReviewID: 1, StoreID: 100, UserID: 200}
+				repo := &memoryReviewRepo{
+					reviews: map[int64]*model.ReviewInfo{1: review},
+					deleted: map[int64]*model.ReviewInfo{1: review},
+				}
+				uc := &ReviewUsecase{repo: repo, log: log.NewHelper(log.DefaultLogger)}
+				ctx := NewCallerContext(context.Background(), tt.caller)
+				var err error
+				if restore {
+					err = uc.RestoreReview(ctx, tt.param)
+				} else {
+					err = uc.DeleteReview(ctx, tt.param)
+				}
+				if tt.wantErr != nil {
+					if !tt.wantErr(err) {
+						t.Fatalf("restore:%v error = %v", restore, err)
+					}
+					if repo.lastDelete != nil {
+						t.Fatalf("restore:%v review should not be changed", restore)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("restore:%v error = %v", restore, err)
+				}
+				if repo.lastDelete.UserID != tt.wantUserID || repo.lastDelete.OpUser != tt.wantOpUser {
+					t.Fatalf("restore:%v operator userID:%d opUser:%q, want userID:%d opUser:%q",
+						restore, repo.lastDelete.UserID, repo.lastDelete.OpUser, tt.wantUserID, tt.wantOpUser)
+				}
+			})
+		}
+	}
+}
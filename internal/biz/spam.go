@@ -0,0 +1,242 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math/bits"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 没有配置时的默认值
+const (
+	defaultSimHashDistance = 6  // 评价内容较短，汉明距离不超过6认为是近似重复
+	defaultRecentSize      = 50 // 每个用户/店铺保留最近的指纹个数
+	defaultRecentWindow    = 7 * 24 * time.Hour
+	defaultRateWindow      = time.Hour
+	defaultRateLimit       = 10 // 每个时间窗口内每个用户最多发布的评价数
+)
+
+// minFingerprintLen 内容太短时指纹没有区分度（比如“好评”），不做重复检测
+const minFingerprintLen = 10
+
+// Fingerprint 评价内容的指纹
+type Fingerprint struct {
+	ReviewID int64
+	Hash     uint64
+}
+
+// SpamRepo 保存最近发布的评价指纹和用户的发布频率
+type SpamRepo interface {
+	// ListUserFingerprints 查询用户最近发布的评价指纹
+	ListUserFingerprints(ctx context.Context, userID int64) ([]*Fingerprint, error)
+	// ListStoreFingerprints 查询店铺最近收到的评价指纹
+	ListStoreFingerprints(ctx context.Context, storeID int64) ([]*Fingerprint, error)
+	// SaveFingerprint 记录一条评价的指纹，每个用户和店铺最多保留size条，保留window时间
+	SaveFingerprint(ctx context.Context, userID, storeID int64, fp *Fingerprint, size int, window time.Duration) error
+	// GetUserReviewCount 查询用户在当前时间窗口内发布的评价数
+	GetUserReviewCount(ctx context.Context, userID int64) (int64, error)
+	// IncrUserReviewCount 增加用户在当前时间窗口内发布的评价数
+	IncrUserReviewCount(ctx context.Context, userID int64, n int64, window time.Duration) error
+}
+
+// SpamResult 重复内容和发布频率的检测结果
+type SpamResult struct {
+	Fingerprint string   `json:"fingerprint,omitempty"` // 内容的SimHash，16进制
+	SimilarTo   int64    `json:"similar_to,omitempty"`  // 近似重复的评价ID
+	Count       int64    `json:"count,omitempty"`       // 当前时间窗口内用户已发布的评价数
+	Reasons     []string `json:"reasons,omitempty"`     // 被标记的原因，为空表示正常
+}
+
+// Flagged 是否被标记为疑似刷评
+func (r *SpamResult) Flagged() bool {
+	return len(r.Reasons) > 0
+}
+
+// SpamChecker 检测复制粘贴的重复评价和短时间内大量发布评价的用户
+// 被标记的评价不会被拒绝，而是转人工审核
+type SpamChecker struct {
+	repo         SpamRepo
+	enable       bool
+	distance     int
+	recentSize   int
+	recentWindow time.Duration
+	rateWindow   time.Duration
+	rateLimit    int64
+	log          *log.Helper
+}
+
+func NewSpamChecker(repo SpamRepo, cfg *conf.Review, logger log.Logger) *SpamChecker {
+	c := cfg.GetSpam()
+	s := &SpamChecker{
+		repo:         repo,
+		enable:       c.GetEnable(),
+		distance:     defaultSimHashDistance,
+		recentSize:   defaultRecentSize,
+		recentWindow: defaultRecentWindow,
+		rateWindow:   defaultRateWindow,
+		rateLimit:    defaultRateLimit,
+		log:          log.NewHelper(logger),
+	}
+	if c.GetSimhashDistance() > 0 {
+		s.distance = int(c.GetSimhashDistance())
+	}
+	if c.GetRecentSize() > 0 {
+		s.recentSize = int(c.GetRecentSize())
+	}
+	if d := c.GetRecentWindow(); d != nil && d.AsDuration() > 0 {
+		s.recentWindow = d.AsDuration()
+	}
+	if d := c.GetRateWindow(); d != nil && d.AsDuration() > 0 {
+		s.rateWindow = d.AsDuration()
+	}
+	if c.GetRateLimit() > 0 {
+		s.rateLimit = int64(c.GetRateLimit())
+	}
+	return s
+}
+
+// Check 检测一条评价，n是本次一起发布的评价数，batch是同一批中排在前面的评价的指纹
+// redis出错时只记录日志，不影响评价发布
+func (s *SpamChecker) Check(ctx context.Context, review *model.ReviewInfo, n int, batch []*Fingerprint) *SpamResult {
+	ret := &SpamResult{}
+	if !s.enable {
+		return ret
+	}
+	count, err := s.repo.GetUserReviewCount(ctx, review.UserID)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("GetUserReviewCount fail, userID:%d err:%v", review.UserID, err)
+	}
+	ret.Count = count
+	if count+int64(n) > s.rateLimit {
+		ret.Reasons = append(ret.Reasons, fmt.Sprintf("用户%v内发布了%d条评价", s.rateWindow, count+int64(n)))
+	}
+
+	hash, ok := SimHash(review.Content)
+	if !ok {
+		return ret
+	}
+	ret.Fingerprint = fmt.Sprintf("%016x", hash)
+	userFps, err := s.repo.ListUserFingerprints(ctx, review.UserID)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("ListUserFingerprints fail, userID:%d err:%v", review.UserID, err)
+	}
+	if id := s.similar(hash, userFps, review.ReviewID); id > 0 {
+		ret.SimilarTo = id
+		ret.Reasons = append(ret.Reasons, "和该用户最近的评价内容重复")
+		return ret
+	}
+	// 同一批的评价还没有保存指纹，需要在批内互相比较
+	if id := s.similar(hash, batch, review.ReviewID); id > 0 {
+		ret.SimilarTo = id
+		ret.Reasons = append(ret.Reasons, "和同时发布的其他评价内容重复")
+		return ret
+	}
+	storeFps, err := s.repo.ListStoreFingerprints(ctx, review.StoreID)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("ListStoreFingerprints fail, storeID:%d err:%v", review.StoreID, err)
+	}
+	if id := s.similar(hash, storeFps, review.ReviewID); id > 0 {
+		ret.SimilarTo = id
+		ret.Reasons = append(ret.Reasons, "和该店铺最近的评价内容重复")
+	}
+	return ret
+}
+
+// Record 评价发布成功后记录指纹和发布次数
+func (s *SpamChecker) Record(ctx context.Context, reviews ...*model.ReviewInfo) {
+	if !s.enable || len(reviews) == 0 {
+		return
+	}
+	userID := reviews[0].UserID
+	if err := s.repo.IncrUserReviewCount(ctx, userID, int64(len(reviews)), s.rateWindow); err != nil {
+		s.log.WithContext(ctx).Errorf("IncrUserReviewCount fail, userID:%d err:%v", userID, err)
+	}
+	for _, review := range reviews {
+		s.RecordFingerprint(ctx, review)
+	}
+}
+
+// RecordFingerprint 只记录评价内容的指纹，用于修改评价，修改不计入发布次数
+func (s *SpamChecker) RecordFingerprint(ctx context.Context, review *model.ReviewInfo) {
+	if !s.enable {
+		return
+	}
+	hash, ok := SimHash(review.Content)
+	if !ok {
+		return
+	}
+	fp := &Fingerprint{ReviewID: review.ReviewID, Hash: hash}
+	if err := s.repo.SaveFingerprint(ctx, review.UserID, review.StoreID, fp, s.recentSize, s.recentWindow); err != nil {
+		s.log.WithContext(ctx).Errorf("SaveFingerprint fail, reviewID:%d err:%v", review.ReviewID, err)
+	}
+}
+
+// similar 返回和hash近似重复的评价ID，没有时返回0
+// 修改评价时评价自己之前的指纹不算重复
+func (s *SpamChecker) similar(hash uint64, fps []*Fingerprint, reviewID int64) int64 {
+	for _, fp := range fps {
+		if fp.ReviewID == reviewID {
+			continue
+		}
+		if bits.OnesCount64(hash^fp.Hash) <= s.distance {
+			return fp.ReviewID
+		}
+	}
+	return 0
+}
+
+// SimHash 计算内容的64位SimHash
+// 忽略空白和标点、不区分大小写，以相邻两个字符为特征；内容太短时返回false
+func SimHash(text string) (uint64, bool) {
+	runes := make([]rune, 0, len(text))
+	for _, r := range text {
+		if unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r) {
+			continue
+		}
+		runes = append(runes, unicode.ToLower(r))
+	}
+	if len(runes) < minFingerprintLen {
+		return 0, false
+	}
+	var weights [64]int
+	h := fnv.New64a()
+	for i := 0; i+1 < len(runes); i++ {
+		h.Reset()
+		h.Write([]byte(string(runes[i : i+2])))
+		sum := h.Sum64()
+		for b := 0; b < 64; b++ {
+			if sum&(1<<uint(b)) != 0 {
+				weights[b]++
+			} else {
+				weights[b]--
+			}
+		}
+	}
+	var hash uint64
+	for b := 0; b < 64; b++ {
+		if weights[b] > 0 {
+			hash |= 1 << uint(b)
+		}
+	}
+	return hash, true
+}
+
+// checkSpam 检测疑似刷评的评价，被标记的评价转人工审核
+func (uc *ReviewUsecase) checkSpam(ctx context.Context, review *model.ReviewInfo, n int, batch []*Fingerprint, ctrl *CtrlInfo) {
+	ret := uc.spam.Check(ctx, review, n, batch)
+	if ret.Flagged() {
+		uc.log.WithContext(ctx).Infof("review flagged as spam, userID:%d orderID:%d reasons:%s",
+			review.UserID, review.OrderID, strings.Join(ret.Reasons, ";"))
+		review.Status = ReviewStatusPending
+	}
+	if ret.Fingerprint != "" || ret.Flagged() {
+		ctrl.Spam = ret
+	}
+}
@@ -0,0 +1,142 @@
+package biz
+
+import (
+	"context"
+	"math/bits"
+	"review-service/internal/data/model"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// emptySpamRepo 测试用的SpamRepo，没有任何历史指纹和发布记录
+type emptySpamRepo struct{}
+
+func (emptySpamRepo) ListUserFingerprints(ctx context.Context, userID int64) ([]*Fingerprint, error) {
+	return nil, nil
+}
+
+func (emptySpamRepo) ListStoreFingerprints(ctx context.Context, storeID int64) ([]*Fingerprint, error) {
+	return nil, nil
+}
+
+func (emptySpamRepo) SaveFingerprint(ctx context.Context, userID, storeID int64, fp *Fingerprint, size int, window time.Duration) error {
+	return nil
+}
+
+func (emptySpamRepo) GetUserReviewCount(ctx context.Context, userID int64) (int64, error) {
+	return 0, nil
+}
+
+func (emptySpamRepo) IncrUserReviewCount(ctx context.Context, userID int64, n int64, window time.Duration) error {
+	return nil
+}
+
+func TestSpamCheckerSimilar(t *testing.T) {
+	const hash uint64 = 0xF0F0F0F0F0F0F0F0
+	s := &SpamChecker{distance: 3}
+	tests := []struct {
+		name     string
+		fps      []*Fingerprint
+		reviewID int64
+		want     int64
+	}{
+		{"没有指纹", nil, 0, 0},
+		{"完全相同", []*Fingerprint{{ReviewID: 1, Hash: hash}}, 0, 1},
+		{"距离等于阈值", []*Fingerprint{{ReviewID: 2, Hash: hash ^ 0b111}}, 0, 2},
+		{"距离超过阈值", []*Fingerprint{{ReviewID: 3, Hash: hash ^ 0b1111}}, 0, 0},
+		{"高位不同也计入距离", []*Fingerprint{{ReviewID: 4, Hash: hash ^ (0xF << 60)}}, 0, 0},
+		{"返回第一个近似的", []*Fingerprint{{ReviewID: 5, Hash: ^hash}, {ReviewID: 6, Hash: hash ^ 1}, {ReviewID: 7, Hash: hash}}, 0, 6},
+		{"跳过评价自己的指纹", []*Fingerprint{{ReviewID: 8, Hash: hash}, {ReviewID: 9, Hash: hash ^ 0b11}}, 8, 9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.similar(hash, tt.fps, tt.reviewID); got != tt.want {
+				t.Fatalf("similar() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSimHash(t *testing.T) {
+	const base = "这件衣服质量很好，穿着很舒服，物流也很快，推荐购买"
+	baseHash, ok := SimHash(base)
+	if !ok {
+		t.Fatalf("SimHash(%q) not ok", base)
+	}
+	tests := []struct {
+		name   string
+		text   string
+		wantOK bool
+		same   bool // 和base的指纹是否相同
+	}{
+		{"内容太短", "好评好评", false, false},
+		{"去掉标点后太短", "好！评！好！评！好！", false, false},
+		{"相同内容", base, true, true},
+		{"只有标点和空白不同", "这件衣服质量很好 穿着很舒服 物流也很快 推荐购买！！", true, true},
+		{"完全不同的内容", "快递太慢了，等了一个多星期才到，包装也破了", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, ok := SimHash(tt.text)
+			if ok != tt.wantOK {
+				t.Fatalf("SimHash(%q) ok = %v, want %v", tt.text, ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			d := bits.OnesCount64(hash ^ baseHash)
+			if tt.same && d != 0 {
+				t.Fatalf("SimHash(%q) distance = %d, want 0", tt.text, d)
+			}
+			if !tt.same && d <= defaultSimHashDistance {
+				t.Fatalf("SimHash(%q) distance = %d, want > %d", tt.text, d, defaultSimHashDistance)
+			}
+		})
+	}
+}
+
+func TestSimHashCaseInsensitive(t *testing.T) {
+	tests := []struct {
+		a, b string
+	}{
+		{"Great Product, Would Buy Again", "great product would buy again"},
+		{"FAST SHIPPING and nice PACKAGING", "fast shipping AND NICE packaging"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a, func(t *testing.T) {
+			ha, _ := SimHash(tt.a)
+			hb, _ := SimHash(tt.b)
+			if ha != hb {
+				t.Fatalf("SimHash(%q) = %016x, SimHash(%q) = %016x, want equal", tt.a, ha, tt.b, hb)
+			}
+		})
+	}
+}
+
+func TestSpamCheckerCheckBatch(t *testing.T) {
+	const content = "这件衣服质量很好，穿着很舒服，物流也很快，推荐购买"
+	hash, _ := SimHash(content)
+	other, _ := SimHash("快递太慢了，等了一个多星期才到，包装也破了")
+	s := &SpamChecker{repo: emptySpamRepo{}, enable: true, distance: defaultSimHashDistance, rateLimit: defaultRateLimit, log: log.NewHelper(log.DefaultLogger)}
+	tests := []struct {
+		name    string
+		batch   []*Fingerprint
+		want    int64
+		flagged bool
+	}{
+		{"批内第一条", nil, 0, false},
+		{"和批内前面的评价重复", []*Fingerprint{{ReviewID: 1, Hash: other}, {ReviewID: 2, Hash: hash}}, 2, true},
+		{"和批内前面的评价不重复", []*Fingerprint{{ReviewID: 1, Hash: other}}, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			review := &model.ReviewInfo{ReviewID: 3, UserID: 100, StoreID: 200, Content: content}
+			ret := s.Check(context.Background(), review, 3, tt.batch)
+			if ret.SimilarTo != tt.want || ret.Flagged() != tt.flagged {
+				t.Fatalf("Check() similarTo = %d flagged = %v, want %d %v", ret.SimilarTo, ret.Flagged(), tt.want, tt.flagged)
+			}
+		})
+	}
+}
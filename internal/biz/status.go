@@ -0,0 +1,134 @@
+package biz
+
+import (
+	"fmt"
+	v1 "review-service/api/review/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 评价状态 review_info.status
+const (
+	ReviewStatusPending  int32 = 10 // 待审核
+	ReviewStatusApproved int32 = 20 // 审核通过
+	ReviewStatusRejected int32 = 30 // 审核不通过
+	ReviewStatusHidden   int32 = 40 // 隐藏
+)
+
+// 申诉状态 review_appeal_info.status
+const (
+	AppealStatusPending   int32 = 10 // 待审核
+	AppealStatusApproved  int32 = 20 // 申诉通过
+	AppealStatusRejected  int32 = 30 // 申诉驳回
+	AppealStatusWithdrawn int32 = 40 // 已撤回
+)
+
+// 追评状态 review_append_info.status
+const (
+	AppendStatusPending  int32 = 10 // 待审核
+	AppendStatusApproved int32 = 20 // 审核通过
+	AppendStatusRejected int32 = 30 // 审核不通过
+)
+
+// 评价的商家回复状态 review_info.has_reply
+// 商家撤回回复后不改回0，保留回复过的标记，商家可以手动重新回复，但是自动回复不再处理
+const (
+	ReplyStateNone      int32 = 0 // 没有回复
+	ReplyStateReplied   int32 = 1 // 已回复
+	ReplyStateWithdrawn int32 = 2 // 回复已撤回
+)
+
+// StatusMachine 状态机
+// 声明一类数据所有合法的状态流转，所有写操作在落库之前都要先经过状态机校验
+type StatusMachine struct {
+	initial     int32             // 新建数据的初始状态
+	transitions map[int32][]int32 // 当前状态 -> 允许变更到的状态
+	desc        map[int32]string
+	newError    func(format string, args ...interface{}) *errors.Error
+}
+
+// ReviewStatusMachine 评价的状态机
+//
+//	10待审核 -> 10待审核（用户修改评价） / 20审核通过 / 30审核不通过
+//	20审核通过 -> 10待审核（用户修改评价） / 40隐藏（商家申诉通过）
+//	30审核不通过 -> 10待审核（用户修改评价）
+var ReviewStatusMachine = &StatusMachine{
+	initial: ReviewStatusPending,
+	transitions: map[int32][]int32{
+		ReviewStatusPending:  {ReviewStatusPending, ReviewStatusApproved, ReviewStatusRejected},
+		ReviewStatusApproved: {ReviewStatusPending, ReviewStatusHidden},
+		ReviewStatusRejected: {ReviewStatusPending},
+	},
+	desc: map[int32]string{
+		ReviewStatusPending:  "待审核",
+		ReviewStatusApproved: "审核通过",
+		ReviewStatusRejected: "审核不通过",
+		ReviewStatusHidden:   "隐藏",
+	},
+	newError: v1.ErrorReviewStatusInvalid,
+}
+
+// AppealStatusMachine 申诉的状态机
+//
+//	10待审核 -> 20申诉通过 / 30申诉驳回 / 40已撤回（商家撤回或者重新提交申诉）
+//
+// 每次提交申诉都是一条新记录，撤回后可以重新申诉，通过和驳回是终态
+var AppealStatusMachine = &StatusMachine{
+	initial: AppealStatusPending,
+	transitions: map[int32][]int32{
+		AppealStatusPending: {AppealStatusApproved, AppealStatusRejected, AppealStatusWithdrawn},
+	},
+	desc: map[int32]string{
+		AppealStatusPending:   "待审核",
+		AppealStatusApproved:  "申诉通过",
+		AppealStatusRejected:  "申诉驳回",
+		AppealStatusWithdrawn: "已撤回",
+	},
+	newError: v1.ErrorAppealStatusInvalid,
+}
+
+// AppendStatusMachine 追评的状态机
+//
+//	10待审核 -> 20审核通过 / 30审核不通过
+var AppendStatusMachine = &StatusMachine{
+	initial: AppendStatusPending,
+	transitions: map[int32][]int32{
+		AppendStatusPending: {AppendStatusApproved, AppendStatusRejected},
+	},
+	desc: map[int32]string{
+		AppendStatusPending:  "待审核",
+		AppendStatusApproved: "审核通过",
+		AppendStatusRejected: "审核不通过",
+	},
+	newError: v1.ErrorAppendStatusInvalid,
+}
+
+// Initial 新建数据时的状态
+func (m *StatusMachine) Initial() int32 {
+	return m.initial
+}
+
+// Can 判断能否从from状态变更到to状态
+func (m *StatusMachine) Can(from, to int32) bool {
+	for _, s := range m.transitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Transit 校验状态变更，不合法时返回对应的业务错误
+func (m *StatusMachine) Transit(from, to int32) error {
+	if m.Can(from, to) {
+		return nil
+	}
+	return m.newError("状态不允许从[%s]变更为[%s]", m.name(from), m.name(to))
+}
+
+func (m *StatusMachine) name(status int32) string {
+	if d, ok := m.desc[status]; ok {
+		return d
+	}
+	return fmt.Sprintf("未知状态%d", status)
+}
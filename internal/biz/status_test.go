@@ -0,0 +1,113 @@
+package biz
+
+import (
+	v1 "review-service/api/review/v1"
+	"testing"
+)
+
+func TestReviewStatusMachine(t *testing.T) {
+	tests := []struct {
+		name string
+		from int32
+		to   int32
+		want bool
+	}{
+		{"待审核修改", ReviewStatusPending, ReviewStatusPending, true},
+		{"待审核通过", ReviewStatusPending, ReviewStatusApproved, true},
+		{"待审核不通过", ReviewStatusPending, ReviewStatusRejected, true},
+		{"待审核不能隐藏", ReviewStatusPending, ReviewStatusHidden, false},
+		{"审核通过后修改", ReviewStatusApproved, ReviewStatusPending, true},
+		{"审核通过后隐藏", ReviewStatusApproved, ReviewStatusHidden, true},
+		{"审核通过不能再驳回", ReviewStatusApproved, ReviewStatusRejected, false},
+		{"审核不通过后修改", ReviewStatusRejected, ReviewStatusPending, true},
+		{"审核不通过不能直接通过", ReviewStatusRejected, ReviewStatusApproved, false},
+		{"隐藏是终态", ReviewStatusHidden, ReviewStatusPending, false},
+		{"未知状态", 0, ReviewStatusApproved, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ReviewStatusMachine.Can(tt.from, tt.to); got != tt.want {
+				t.Fatalf("Can(%d, %d) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+			err := ReviewStatusMachine.Transit(tt.from, tt.to)
+			if tt.want && err != nil {
+				t.Fatalf("Transit(%d, %d) unexpected error: %v", tt.from, tt.to, err)
+			}
+			if !tt.want && !v1.IsReviewStatusInvalid(err) {
+				t.Fatalf("Transit(%d, %d) = %v, want REVIEW_STATUS_INVALID", tt.from, tt.to, err)
+			}
+		})
+	}
+}
+
+func TestAppealStatusMachine(t *testing.T) {
+	tests := []struct {
+		name string
+		from int32
+		to   int32
+		want bool
+	}{
+		{"待审核通过", AppealStatusPending, AppealStatusApproved, true},
+		{"待审核驳回", AppealStatusPending, AppealStatusRejected, true},
+		{"待审核撤回", AppealStatusPending, AppealStatusWithdrawn, true},
+		{"通过是终态", AppealStatusApproved, AppealStatusRejected, false},
+		{"驳回是终态", AppealStatusRejected, AppealStatusApproved, false},
+		{"撤回后不能再审核", AppealStatusWithdrawn, AppealStatusApproved, false},
+		{"不能重复撤回", AppealStatusWithdrawn, AppealStatusWithdrawn, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := AppealStatusMachine.Transit(tt.from, tt.to)
+			if tt.want && err != nil {
+				t.Fatalf("Transit(%d, %d) unexpected error: %v", tt.from, tt.to, err)
+			}
+			if !tt.want && !v1.IsAppealStatusInvalid(err) {
+				t.Fatalf("Transit(%d, %d) = %v, want APPEAL_STATUS_INVALID", tt.from, tt.to, err)
+			}
+		})
+	}
+}
+
+func TestAppendStatusMachine(t *testing.T) {
+	tests := []struct {
+		name string
+		from int32
+		to   int32
+		want bool
+	}{
+		{"待审核通过", AppendStatusPending, AppendStatusApproved, true},
+		{"待审核不通过", AppendStatusPending, AppendStatusRejected, true},
+		{"审核通过是终态", AppendStatusApproved, AppendStatusRejected, false},
+		{"审核不通过是终态", AppendStatusRejected, AppendStatusApproved, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := AppendStatusMachine.Transit(tt.from, tt.to)
+			if tt.want && err != nil {
+				t.Fatalf("Transit(%d, %d) unexpected error: %v", tt.from, tt.to, err)
+			}
+			if !tt.want && !v1.IsAppendStatusInvalid(err) {
+				t.Fatalf("Transit(%d, %d) = %v, want APPEND_STATUS_INVALID", tt.from, tt.to, err)
+			}
+		})
+	}
+}
+
+func TestStatusMachineInitial(t *testing.T) {
+	tests := []struct {
+		name    string
+		machine *StatusMachine
+		want    int32
+	}{
+		{"评价", ReviewStatusMachine, ReviewStatusPending},
+		{"申诉", AppealStatusMachine, AppealStatusPending},
+		{"追评", AppendStatusMachine, AppendStatusPending},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.machine.Initial(); got != tt.want {
+				t.Fatalf("Initial() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package data
+
+import (
+	"context"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+// WithdrawAppeal 商家撤回待审核的申诉
+func (r *reviewRepo) WithdrawAppeal(ctx context.Context, appeal *model.ReviewAppealInfo) error {
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		return withdrawAppeal(ctx, tx, appeal, strconv.FormatInt(appeal.StoreID, 10), "")
+	})
+	return dbError(err, nil)
+}
+
+// withdrawAppeal 在事务中把待审核的申诉改为已撤回并记录操作日志
+func withdrawAppeal(ctx context.Context, tx *query.Query, appeal *model.ReviewAppealInfo, actor, reason string) error {
+	info, err := tx.ReviewAppealInfo.
+		WithContext(ctx).
+		Where(
+			tx.ReviewAppealInfo.AppealID.Eq(appeal.AppealID),
+			tx.ReviewAppealInfo.Status.Eq(biz.AppealStatusPending),
+			tx.ReviewAppealInfo.Version.Eq(appeal.Version),
+		).
+		Updates(map[string]interface{}{
+			"status":    biz.AppealStatusWithdrawn,
+			"update_by": actor,
+			"version":   gorm.Expr("version + 1"),
+		})
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return v1.ErrorVersionConflict("申诉:%d已被修改，请重试", appeal.AppealID)
+	}
+	return addOperationLog(ctx, tx, &model.ReviewOperationLog{
+		ReviewID:   appeal.ReviewID,
+		AppealID:   appeal.AppealID,
+		TargetType: biz.OpTargetAppeal,
+		Action:     biz.OpActionWithdrawAppeal,
+		Actor:      actor,
+		OldStatus:  appeal.Status,
+		NewStatus:  biz.AppealStatusWithdrawn,
+		Reason:     reason,
+	})
+}
+
+// ListAppeals 按条件分页查询申诉，按申诉时间倒序
+func (r *reviewRepo) ListAppeals(ctx context.Context, param *biz.ListAppealParam, offset, limit int) ([]*model.ReviewAppealInfo, int64, error) {
+	q := r.data.query.ReviewAppealInfo
+	do := q.WithContext(ctx)
+	if param.StoreID > 0 {
+		do = do.Where(q.StoreID.Eq(param.StoreID))
+	}
+	if param.Status > 0 {
+		do = do.Where(q.Status.Eq(param.Status))
+	}
+	if param.Reason != "" {
+		do = do.Where(q.Reason.Eq(param.Reason))
+	}
+	if !param.StartTime.IsZero() {
+		do = do.Where(q.CreateAt.Gte(param.StartTime))
+	}
+	if !param.EndTime.IsZero() {
+		do = do.Where(q.CreateAt.Lt(param.EndTime))
+	}
+	appeals, total, err := do.Order(q.ID.Desc()).FindByPage(offset, limit)
+	if err != nil {
+		return nil, 0, dbError(err, nil)
+	}
+	return appeals, total, nil
+}
+
+// ListAppealByReviewID 查询评价的全部申诉记录，按申诉时间倒序（走idx_review_id索引）
+func (r *reviewRepo) ListAppealByReviewID(ctx context.Context, reviewID int64) ([]*model.ReviewAppealInfo, error) {
+	appeals, err := r.data.query.ReviewAppealInfo.
+		WithContext(ctx).
+		Where(r.data.query.ReviewAppealInfo.ReviewID.Eq(reviewID)).
+		Order(r.data.query.ReviewAppealInfo.ID.Desc()).
+		Find()
+	return appeals, dbError(err, nil)
+}
+
+// CountAppealByReason 按申诉原因和状态统计申诉数
+func (r *reviewRepo) CountAppealByReason(ctx context.Context, param *biz.AppealReasonStatParam) ([]*biz.AppealReasonCount, error) {
+	q := r.data.query.ReviewAppealInfo
+	do := q.WithContext(ctx)
+	if param.StoreID > 0 {
+		do = do.Where(q.StoreID.Eq(param.StoreID))
+	}
+	if !param.StartTime.IsZero() {
+		do = do.Where(q.CreateAt.Gte(param.StartTime))
+	}
+	if !param.EndTime.IsZero() {
+		do = do.Where(q.CreateAt.Lt(param.EndTime))
+	}
+	var counts []*biz.AppealReasonCount
+	err := do.Select(q.Reason, q.Status, q.ID.Count().As("count")).
+		Group(q.Reason, q.Status).
+		Scan(&counts)
+	return counts, dbError(err, nil)
+}
@@ -0,0 +1,214 @@
+package data
+
+import (
+	"context"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type autoReplyRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewAutoReplyRepo .
+func NewAutoReplyRepo(data *Data, logger log.Logger) biz.AutoReplyRepo {
+	return &autoReplyRepo{data: data, log: log.NewHelper(logger)}
+}
+
+// SaveTemplate 创建回复模板
+func (r *autoReplyRepo) SaveTemplate(ctx context.Context, tpl *model.ReviewReplyTemplate) error {
+	return dbError(r.data.query.ReviewReplyTemplate.WithContext(ctx).Save(tpl), nil)
+}
+
+// GetTemplate 根据模板ID查询模板
+func (r *autoReplyRepo) GetTemplate(ctx context.Context, templateID int64) (*model.ReviewReplyTemplate, error) {
+	tpl, err := r.data.query.ReviewReplyTemplate.
+		WithContext(ctx).
+		Where(r.data.query.ReviewReplyTemplate.TemplateID.Eq(templateID)).
+		First()
+	if err != nil {
+		return nil, dbError(err, v1.ErrorTemplateNotFound("模板:%d不存在", templateID))
+	}
+	return tpl, nil
+}
+
+// UpdateTemplate 修改模板的名称和内容
+func (r *autoReplyRepo) UpdateTemplate(ctx context.Context, tpl *model.ReviewReplyTemplate, param *biz.ReplyTemplateParam) error {
+	info, err := r.data.query.ReviewReplyTemplate.
+		WithContext(ctx).
+		Where(
+			r.data.query.ReviewReplyTemplate.TemplateID.Eq(tpl.TemplateID),
+			r.data.query.ReviewReplyTemplate.Version.Eq(tpl.Version),
+		).
+		Updates(map[string]interface{}{
+			"name":      param.Name,
+			"content":   param.Content,
+			"update_by": strconv.FormatInt(param.StoreID, 10),
+			"version":   gorm.Expr("version + 1"),
+		})
+	if err != nil {
+		return dbError(err, nil)
+	}
+	if info.RowsAffected == 0 {
+		return v1.ErrorVersionConflict("模板:%d已被修改，请重试", tpl.TemplateID)
+	}
+	return nil
+}
+
+// DeleteTemplate 逻辑删除模板
+func (r *autoReplyRepo) DeleteTemplate(ctx context.Context, tpl *model.ReviewReplyTemplate) error {
+	info, err := r.data.query.ReviewReplyTemplate.
+		WithContext(ctx).
+		Where(
+			r.data.query.ReviewReplyTemplate.TemplateID.Eq(tpl.TemplateID),
+			r.data.query.ReviewReplyTemplate.Version.Eq(tpl.Version),
+		).
+		Updates(map[string]interface{}{
+			"delete_at": time.Now(),
+			"update_by": strconv.FormatInt(tpl.StoreID, 10),
+			"version":   gorm.Expr("version + 1"),
+		})
+	if err != nil {
+		return dbError(err, nil)
+	}
+	if info.RowsAffected == 0 {
+		return v1.ErrorVersionConflict("模板:%d已被修改，请重试", tpl.TemplateID)
+	}
+	return nil
+}
+
+// ListTemplateByStoreID 查询店铺的全部模板（走idx_store_id索引）
+func (r *autoReplyRepo) ListTemplateByStoreID(ctx context.Context, storeID int64) ([]*model.ReviewReplyTemplate, error) {
+	templates, err := r.data.query.ReviewReplyTemplate.
+		WithContext(ctx).
+		Where(r.data.query.ReviewReplyTemplate.StoreID.Eq(storeID)).
+		Order(r.data.query.ReviewReplyTemplate.ID).
+		Find()
+	return templates, dbError(err, nil)
+}
+
+// SaveRule 创建自动回复规则
+func (r *autoReplyRepo) SaveRule(ctx context.Context, rule *model.ReviewAutoReplyRule) error {
+	return dbError(r.data.query.ReviewAutoReplyRule.WithContext(ctx).Save(rule), nil)
+}
+
+// GetRule 根据规则ID查询规则
+func (r *autoReplyRepo) GetRule(ctx context.Context, ruleID int64) (*model.ReviewAutoReplyRule, error) {
+	rule, err := r.data.query.ReviewAutoReplyRule.
+		WithContext(ctx).
+		Where(r.data.query.ReviewAutoReplyRule.RuleID.Eq(ruleID)).
+		First()
+	if err != nil {
+		return nil, dbError(err, v1.ErrorRuleNotFound("规则:%d不存在", ruleID))
+	}
+	return rule, nil
+}
+
+// UpdateRule 修改自动回复规则
+func (r *autoReplyRepo) UpdateRule(ctx context.Context, rule *model.ReviewAutoReplyRule, param *biz.AutoReplyRuleParam) error {
+	enable := 0
+	if param.Enable {
+		enable = 1
+	}
+	info, err := r.data.query.ReviewAutoReplyRule.
+		WithContext(ctx).
+		Where(
+			r.data.query.ReviewAutoReplyRule.RuleID.Eq(rule.RuleID),
+			r.data.query.ReviewAutoReplyRule.Version.Eq(rule.Version),
+		).
+		Updates(map[string]interface{}{
+			"template_id":  param.TemplateID,
+			"min_score":    param.MinScore,
+			"max_score":    param.MaxScore,
+			"content_type": param.ContentType,
+			"delay":        param.Delay,
+			"priority":     param.Priority,
+			"enable":       enable,
+			"update_by":    strconv.FormatInt(param.StoreID, 10),
+			"version":      gorm.Expr("version + 1"),
+		})
+	if err != nil {
+		return dbError(err, nil)
+	}
+	if info.RowsAffected == 0 {
+		return v1.ErrorVersionConflict("规则:%d已被修改，请重试", rule.RuleID)
+	}
+	return nil
+}
+
+// DeleteRule 逻辑删除规则
+func (r *autoReplyRepo) DeleteRule(ctx context.Context, rule *model.ReviewAutoReplyRule) error {
+	info, err := r.data.query.ReviewAutoReplyRule.
+		WithContext(ctx).
+		Where(
+			r.data.query.ReviewAutoReplyRule.RuleID.Eq(rule.RuleID),
+			r.data.query.ReviewAutoReplyRule.Version.Eq(rule.Version),
+		).
+		Updates(map[string]interface{}{
+			"delete_at": time.Now(),
+			"update_by": strconv.FormatInt(rule.StoreID, 10),
+			"version":   gorm.Expr("version + 1"),
+		})
+	if err != nil {
+		return dbError(err, nil)
+	}
+	if info.RowsAffected == 0 {
+		return v1.ErrorVersionConflict("规则:%d已被修改，请重试", rule.RuleID)
+	}
+	return nil
+}
+
+// ListRuleByStoreID 查询店铺的全部规则，按优先级排序（走idx_store_id索引）
+func (r *autoReplyRepo) ListRuleByStoreID(ctx context.Context, storeID int64) ([]*model.ReviewAutoReplyRule, error) {
+	q := r.data.query.ReviewAutoReplyRule
+	rules, err := q.WithContext(ctx).
+		Where(q.StoreID.Eq(storeID)).
+		Order(q.Priority, q.ID).
+		Find()
+	return rules, dbError(err, nil)
+}
+
+// CountRuleByTemplateID 统计使用该模板的规则数
+func (r *autoReplyRepo) CountRuleByTemplateID(ctx context.Context, templateID int64) (int64, error) {
+	n, err := r.data.query.ReviewAutoReplyRule.
+		WithContext(ctx).
+		Where(r.data.query.ReviewAutoReplyRule.TemplateID.Eq(templateID)).
+		Count()
+	return n, dbError(err, nil)
+}
+
+// ListEnabledRules 查询全部启用的规则，同一店铺的规则排在一起并按优先级排序
+func (r *autoReplyRepo) ListEnabledRules(ctx context.Context) ([]*model.ReviewAutoReplyRule, error) {
+	q := r.data.query.ReviewAutoReplyRule
+	rules, err := q.WithContext(ctx).
+		Where(q.Enable.Eq(1)).
+		Order(q.StoreID, q.Priority, q.ID).
+		Find()
+	return rules, dbError(err, nil)
+}
+
+// ListUnrepliedReviews 查询店铺审核通过并且从来没有回复过的评价（走idx_store_reply索引）
+// 商家撤回回复的评价has_reply是2，不会被查出来
+func (r *autoReplyRepo) ListUnrepliedReviews(ctx context.Context, storeID int64, from, to time.Time, afterID int64, limit int) ([]*model.ReviewInfo, error) {
+	q := r.data.query.ReviewInfo
+	reviews, err := q.WithContext(ctx).
+		Where(
+			q.StoreID.Eq(storeID),
+			q.HasReply.Eq(biz.ReplyStateNone),
+			q.Status.Eq(biz.ReviewStatusApproved),
+			q.CreateAt.Gte(from),
+			q.CreateAt.Lt(to),
+			q.ID.Gt(afterID),
+		).
+		Order(q.ID).
+		Limit(limit).
+		Find()
+	return reviews, dbError(err, nil)
+}
@@ -0,0 +1,163 @@
+package data
+
+import (
+	"context"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	goodsv1 "review-service/third_party/goods/v1"
+	orderv1 "review-service/third_party/order/v1"
+	userv1 "review-service/third_party/user/v1"
+
+	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/hashicorp/consul/api"
+)
+
+// 下游服务在consul中注册的服务名
+const (
+	orderServiceEndpoint = "discovery:///order.service"
+	goodsServiceEndpoint = "discovery:///goods.service"
+	userServiceEndpoint  = "discovery:///user.service"
+)
+
+// NewDiscovery 使用consul做服务发现，和服务注册用的是同一个consul
+func NewDiscovery(conf *conf.Registry) (registry.Discovery, error) {
+	c := api.DefaultConfig()
+	c.Address = conf.Consul.Address
+	c.Scheme = conf.Consul.Scheme
+	client, err := api.NewClient(c)
+	if err != nil {
+		return nil, err
+	}
+	return consul.New(client), nil
+}
+
+// NewOrderServiceClient 通过服务发现连接订单服务
+func NewOrderServiceClient(d registry.Discovery) (orderv1.OrderClient, func(), error) {
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint(orderServiceEndpoint),
+		grpc.WithDiscovery(d),
+		grpc.WithMiddleware(recovery.Recovery()),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return orderv1.NewOrderClient(conn), func() { conn.Close() }, nil
+}
+
+// NewGoodsServiceClient 通过服务发现连接商品服务
+func NewGoodsServiceClient(d registry.Discovery) (goodsv1.GoodsClient, func(), error) {
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint(goodsServiceEndpoint),
+		grpc.WithDiscovery(d),
+		grpc.WithMiddleware(recovery.Recovery()),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return goodsv1.NewGoodsClient(conn), func() { conn.Close() }, nil
+}
+
+// NewUserServiceClient 通过服务发现连接用户服务
+func NewUserServiceClient(d registry.Discovery) (userv1.UserClient, func(), error) {
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint(userServiceEndpoint),
+		grpc.WithDiscovery(d),
+		grpc.WithMiddleware(recovery.Recovery()),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return userv1.NewUserClient(conn), func() { conn.Close() }, nil
+}
+
+type orderClient struct {
+	client orderv1.OrderClient
+	log    *log.Helper
+}
+
+// NewOrderClient .
+func NewOrderClient(client orderv1.OrderClient, logger log.Logger) biz.OrderClient {
+	return &orderClient{client: client, log: log.NewHelper(logger)}
+}
+
+// GetOrder 查询订单
+func (c *orderClient) GetOrder(ctx context.Context, orderID int64) (*biz.Order, error) {
+	reply, err := c.client.GetOrder(ctx, &orderv1.GetOrderRequest{OrderID: orderID})
+	if err != nil {
+		c.log.WithContext(ctx).Errorf("GetOrder fail, orderID:%d err:%v", orderID, err)
+		return nil, rpcError(err, v1.ErrorOrderInvalid("订单:%d不存在", orderID))
+	}
+	o := reply.GetOrder()
+	order := &biz.Order{
+		OrderID:   o.GetOrderID(),
+		UserID:    o.GetUserID(),
+		StoreID:   o.GetStoreID(),
+		Completed: o.GetStatus() == orderv1.OrderStatus_ORDER_STATUS_COMPLETED,
+		Items:     make([]*biz.OrderItem, 0, len(o.GetItems())),
+	}
+	for _, item := range o.GetItems() {
+		order.Items = append(order.Items, &biz.OrderItem{SkuID: item.GetSkuID(), SpuID: item.GetSpuID()})
+	}
+	return order, nil
+}
+
+type goodsClient struct {
+	client goodsv1.GoodsClient
+	log    *log.Helper
+}
+
+// NewGoodsClient .
+func NewGoodsClient(client goodsv1.GoodsClient, logger log.Logger) biz.GoodsClient {
+	return &goodsClient{client: client, log: log.NewHelper(logger)}
+}
+
+// GetSku 查询sku
+func (c *goodsClient) GetSku(ctx context.Context, skuID int64) (*biz.Sku, error) {
+	reply, err := c.client.GetSku(ctx, &goodsv1.GetSkuRequest{SkuID: skuID})
+	if err != nil {
+		c.log.WithContext(ctx).Errorf("GetSku fail, skuID:%d err:%v", skuID, err)
+		return nil, rpcError(err, v1.ErrorOrderInvalid("商品:%d不存在", skuID))
+	}
+	s := reply.GetSku()
+	return &biz.Sku{
+		SkuID:   s.GetSkuID(),
+		SpuID:   s.GetSpuID(),
+		StoreID: s.GetStoreID(),
+		Title:   s.GetTitle(),
+		Picture: s.GetPicture(),
+		Price:   s.GetPrice(),
+		Specs:   s.GetSpecs(),
+	}, nil
+}
+
+type userClient struct {
+	client userv1.UserClient
+	log    *log.Helper
+}
+
+// NewUserClient .
+func NewUserClient(client userv1.UserClient, logger log.Logger) biz.UserClient {
+	return &userClient{client: client, log: log.NewHelper(logger)}
+}
+
+// GetUser 查询用户
+func (c *userClient) GetUser(ctx context.Context, userID int64) (*biz.User, error) {
+	reply, err := c.client.GetUser(ctx, &userv1.GetUserRequest{UserID: userID})
+	if err != nil {
+		c.log.WithContext(ctx).Errorf("GetUser fail, userID:%d err:%v", userID, err)
+		return nil, rpcError(err, v1.ErrorParamInvalid("用户:%d不存在", userID))
+	}
+	u := reply.GetUser()
+	return &biz.User{
+		UserID:   u.GetUserID(),
+		Nickname: u.GetNickname(),
+	}, nil
+}
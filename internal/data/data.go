@@ -15,7 +15,10 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewReviewRepo, NewDB, NewESClient, NewRedisClient)
+var ProviderSet = wire.NewSet(
+	NewData, NewReviewRepo, NewSpamRepo, NewLeaseRepo, NewAutoReplyRepo, NewOutboxRepo, NewEventPublisher, NewOrderSource, NewDB, NewESClient, NewRedisClient,
+	NewDiscovery, NewOrderServiceClient, NewGoodsServiceClient, NewUserServiceClient, NewOrderClient, NewGoodsClient, NewUserClient,
+)
 
 // Data .
 type Data struct {
@@ -51,7 +54,8 @@ func NewESClient(cfg *conf.Elasticsearch) (*elasticsearch.TypedClient, error) {
 func NewDB(cfg *conf.Data) (*gorm.DB, error) {
 	switch strings.ToLower(cfg.Database.GetDriver()) {
 	case "mysql":
-		return gorm.Open(mysql.Open(cfg.Database.GetSource()))
+		// TranslateError 将数据库方言的错误（比如唯一键冲突）转换成gorm定义的错误
+		return gorm.Open(mysql.Open(cfg.Database.GetSource()), &gorm.Config{TranslateError: true})
 	}
 	return nil, errors.New("connect db fail unsupported db driver")
 }
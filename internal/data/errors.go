@@ -0,0 +1,52 @@
+package data
+
+import (
+	"errors"
+	v1 "review-service/api/review/v1"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+)
+
+// data层返回的错误统一在这里转换成v1中定义的业务错误，
+// 避免gorm/redis/es的错误直接透传给调用方变成500 unknown错误
+
+// dbError 转换gorm返回的错误
+// 已经是业务错误的直接返回；记录不存在时返回notFound（notFound为nil时按数据库错误处理）
+func dbError(err error, notFound *kerrors.Error) error {
+	if err == nil {
+		return nil
+	}
+	var e *kerrors.Error
+	if errors.As(err, &e) {
+		return err
+	}
+	if notFound != nil && errors.Is(err, gorm.ErrRecordNotFound) {
+		return notFound
+	}
+	return v1.ErrorDbFailed("数据库操作失败").WithCause(err)
+}
+
+// cacheError 转换redis返回的错误
+func cacheError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return v1.ErrorCacheFailed("查询缓存失败").WithCause(err)
+}
+
+// rpcError 转换调用下游服务返回的错误，下游返回NotFound时返回notFound
+func rpcError(err error, notFound *kerrors.Error) error {
+	if kerrors.IsNotFound(err) {
+		return notFound
+	}
+	return v1.ErrorDependencyFailed("调用下游服务失败").WithCause(err)
+}
+
+// searchError 转换ES返回的错误
+func searchError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return v1.ErrorSearchFailed("查询ES失败").WithCause(err)
+}
@@ -0,0 +1,63 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// 没有配置时事件stream的默认值
+const (
+	defaultEventStream = "review:events"
+	defaultEventMaxLen = 100000
+)
+
+// eventPublisher 把业务事件写入redis stream，下游服务用消费组消费
+type eventPublisher struct {
+	data   *Data
+	stream string
+	maxLen int64
+	log    *log.Helper
+}
+
+// NewEventPublisher .
+func NewEventPublisher(data *Data, cfg *conf.Review, logger log.Logger) biz.EventPublisher {
+	p := &eventPublisher{
+		data:   data,
+		stream: defaultEventStream,
+		maxLen: defaultEventMaxLen,
+		log:    log.NewHelper(logger),
+	}
+	if s := cfg.GetEvent().GetStream(); s != "" {
+		p.stream = s
+	}
+	if n := cfg.GetEvent().GetMaxLen(); n > 0 {
+		p.maxLen = n
+	}
+	return p
+}
+
+// Publish 发布事件，stream超过maxLen后自动丢弃最早的事件
+func (p *eventPublisher) Publish(ctx context.Context, event *biz.Event) error {
+	payload, err := json.Marshal(event.Payload)
+	if err != nil {
+		return err
+	}
+	err = p.data.rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: p.stream,
+		MaxLen: p.maxLen,
+		Approx: true,
+		Values: map[string]interface{}{
+			"id":        event.ID,
+			"type":      event.Type,
+			"key":       event.Key,
+			"payload":   payload,
+			"create_at": event.CreateAt.Unix(),
+		},
+	}).Err()
+	return cacheError(err)
+}
@@ -0,0 +1,95 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"review-service/internal/biz"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// leaseKey 审核任务和后台任务租约的key，值是领取人，过期时间就是租约的过期时间
+const leaseKey = "review:audit:lease:%d:%d"
+
+// releaseScript 只有租约的持有人才能释放租约，避免租约过期被别人领取后误删
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// renewScript 只有租约的持有人才能续约
+var renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+type leaseRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewLeaseRepo .
+func NewLeaseRepo(data *Data, logger log.Logger) biz.LeaseRepo {
+	return &leaseRepo{data: data, log: log.NewHelper(logger)}
+}
+
+// AcquireLease 领取任务，任务已被领取时返回false
+func (r *leaseRepo) AcquireLease(ctx context.Context, taskType int32, taskID int64, owner string, ttl time.Duration) (bool, error) {
+	ok, err := r.data.rdb.SetNX(ctx, fmt.Sprintf(leaseKey, taskType, taskID), owner, ttl).Result()
+	return ok, cacheError(err)
+}
+
+// GetLeases 批量查询任务的租约
+func (r *leaseRepo) GetLeases(ctx context.Context, taskType int32, taskIDs []int64) (map[int64]*biz.Lease, error) {
+	if len(taskIDs) == 0 {
+		return map[int64]*biz.Lease{}, nil
+	}
+	owners := make([]*redis.StringCmd, len(taskIDs))
+	ttls := make([]*redis.DurationCmd, len(taskIDs))
+	_, err := r.data.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, id := range taskIDs {
+			key := fmt.Sprintf(leaseKey, taskType, id)
+			owners[i] = pipe.Get(ctx, key)
+			ttls[i] = pipe.PTTL(ctx, key)
+		}
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		return nil, cacheError(err)
+	}
+	now := time.Now()
+	ret := make(map[int64]*biz.Lease, len(taskIDs))
+	for i, id := range taskIDs {
+		owner, err := owners[i].Result()
+		if err != nil {
+			// redis.Nil表示没有被领取
+			continue
+		}
+		ret[id] = &biz.Lease{Owner: owner, ExpireAt: now.Add(ttls[i].Val())}
+	}
+	return ret, nil
+}
+
+// ReleaseLease 释放owner持有的租约
+func (r *leaseRepo) ReleaseLease(ctx context.Context, taskType int32, taskID int64, owner string) error {
+	err := releaseScript.Run(ctx, r.data.rdb, []string{fmt.Sprintf(leaseKey, taskType, taskID)}, owner).Err()
+	if err == redis.Nil {
+		return nil
+	}
+	return cacheError(err)
+}
+
+// RenewLease 延长owner持有的租约
+func (r *leaseRepo) RenewLease(ctx context.Context, taskType int32, taskID int64, owner string, ttl time.Duration) (bool, error) {
+	n, err := renewScript.Run(ctx, r.data.rdb, []string{fmt.Sprintf(leaseKey, taskType, taskID)}, owner, ttl.Milliseconds()).Int()
+	if err != nil && err != redis.Nil {
+		return false, cacheError(err)
+	}
+	return n == 1, nil
+}
@@ -0,0 +1,36 @@
+package data
+
+import (
+	"context"
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+)
+
+// addOperationLog 在修改评价或申诉的事务中写入操作日志，和状态修改一起提交或回滚
+func addOperationLog(ctx context.Context, tx *query.Query, oplog *model.ReviewOperationLog) error {
+	return tx.ReviewOperationLog.WithContext(ctx).Create(oplog)
+}
+
+// ListOperationLogs 查询操作日志，按操作时间倒序
+func (r *reviewRepo) ListOperationLogs(ctx context.Context, param *biz.ListOperationLogParam, offset, limit int) ([]*model.ReviewOperationLog, int64, error) {
+	q := r.data.query.ReviewOperationLog
+	do := q.WithContext(ctx)
+	if param.ReviewID > 0 {
+		do = do.Where(q.ReviewID.Eq(param.ReviewID))
+	}
+	if param.Actor != "" {
+		do = do.Where(q.Actor.Eq(param.Actor))
+	}
+	if !param.StartTime.IsZero() {
+		do = do.Where(q.CreateAt.Gte(param.StartTime))
+	}
+	if !param.EndTime.IsZero() {
+		do = do.Where(q.CreateAt.Lt(param.EndTime))
+	}
+	logs, total, err := do.Order(q.ID.Desc()).FindByPage(offset, limit)
+	if err != nil {
+		return nil, 0, dbError(err, nil)
+	}
+	return logs, total, nil
+}
@@ -0,0 +1,74 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// addOutboxEvent 在业务数据的事务中把事件写入发件箱，由EventRelay在事务提交后发送
+func addOutboxEvent(ctx context.Context, tx *query.Query, event *biz.Event) error {
+	payload, err := json.Marshal(event.Payload)
+	if err != nil {
+		return err
+	}
+	return tx.ReviewEventOutbox.WithContext(ctx).Create(&model.ReviewEventOutbox{
+		CreateAt:    event.CreateAt,
+		EventType:   event.Type,
+		EventKey:    event.Key,
+		Payload:     string(payload),
+		Status:      biz.OutboxStatusPending,
+		NextRetryAt: event.CreateAt,
+	})
+}
+
+type outboxRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewOutboxRepo .
+func NewOutboxRepo(data *Data, logger log.Logger) biz.OutboxRepo {
+	return &outboxRepo{data: data, log: log.NewHelper(logger)}
+}
+
+// ListPendingEvents 查询待发送并且到了发送时间的事件（走idx_status_next_retry_at索引）
+func (r *outboxRepo) ListPendingEvents(ctx context.Context, now time.Time, limit int) ([]*model.ReviewEventOutbox, error) {
+	q := r.data.query.ReviewEventOutbox
+	events, err := q.WithContext(ctx).
+		Where(
+			q.Status.Eq(biz.OutboxStatusPending),
+			q.NextRetryAt.Lte(now),
+		).
+		Order(q.ID).
+		Limit(limit).
+		Find()
+	return events, dbError(err, nil)
+}
+
+// MarkEventSent 标记事件已发送
+func (r *outboxRepo) MarkEventSent(ctx context.Context, id int64) error {
+	q := r.data.query.ReviewEventOutbox
+	_, err := q.WithContext(ctx).
+		Where(q.ID.Eq(id)).
+		Update(q.Status, biz.OutboxStatusSent)
+	return dbError(err, nil)
+}
+
+// MarkEventFailed 记录发送失败的次数和原因，推迟到nextRetryAt再发送
+func (r *outboxRepo) MarkEventFailed(ctx context.Context, id int64, retryCount int32, nextRetryAt time.Time, reason string) error {
+	q := r.data.query.ReviewEventOutbox
+	_, err := q.WithContext(ctx).
+		Where(q.ID.Eq(id)).
+		Updates(map[string]interface{}{
+			"retry_count":   retryCount,
+			"next_retry_at": nextRetryAt,
+			"last_error":    reason,
+		})
+	return dbError(err, nil)
+}
@@ -16,44 +16,74 @@ import (
 )
 
 var (
-	Q                = new(Query)
-	ReviewAppealInfo *reviewAppealInfo
-	ReviewInfo       *reviewInfo
-	ReviewReplyInfo  *reviewReplyInfo
+	Q                   = new(Query)
+	ReviewAppealInfo    *reviewAppealInfo
+	ReviewAppendInfo    *reviewAppendInfo
+	ReviewAutoReplyRule *reviewAutoReplyRule
+	ReviewEventOutbox   *reviewEventOutbox
+	ReviewHistory       *reviewHistory
+	ReviewInfo          *reviewInfo
+	ReviewOperationLog  *reviewOperationLog
+	ReviewReplyInfo     *reviewReplyInfo
+	ReviewReplyTemplate *reviewReplyTemplate
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
 	ReviewAppealInfo = &Q.ReviewAppealInfo
+	ReviewAppendInfo = &Q.ReviewAppendInfo
+	ReviewAutoReplyRule = &Q.ReviewAutoReplyRule
+	ReviewEventOutbox = &Q.ReviewEventOutbox
+	ReviewHistory = &Q.ReviewHistory
 	ReviewInfo = &Q.ReviewInfo
+	ReviewOperationLog = &Q.ReviewOperationLog
 	ReviewReplyInfo = &Q.ReviewReplyInfo
+	ReviewReplyTemplate = &Q.ReviewReplyTemplate
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:               db,
-		ReviewAppealInfo: newReviewAppealInfo(db, opts...),
-		ReviewInfo:       newReviewInfo(db, opts...),
-		ReviewReplyInfo:  newReviewReplyInfo(db, opts...),
+		db:                  db,
+		ReviewAppealInfo:    newReviewAppealInfo(db, opts...),
+		ReviewAppendInfo:    newReviewAppendInfo(db, opts...),
+		ReviewAutoReplyRule: newReviewAutoReplyRule(db, opts...),
+		ReviewEventOutbox:   newReviewEventOutbox(db, opts...),
+		ReviewHistory:       newReviewHistory(db, opts...),
+		ReviewInfo:          newReviewInfo(db, opts...),
+		ReviewOperationLog:  newReviewOperationLog(db, opts...),
+		ReviewReplyInfo:     newReviewReplyInfo(db, opts...),
+		ReviewReplyTemplate: newReviewReplyTemplate(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	ReviewAppealInfo reviewAppealInfo
-	ReviewInfo       reviewInfo
-	ReviewReplyInfo  reviewReplyInfo
+	ReviewAppealInfo    reviewAppealInfo
+	ReviewAppendInfo    reviewAppendInfo
+	ReviewAutoReplyRule reviewAutoReplyRule
+	ReviewEventOutbox   reviewEventOutbox
+	ReviewHistory       reviewHistory
+	ReviewInfo          reviewInfo
+	ReviewOperationLog  reviewOperationLog
+	ReviewReplyInfo     reviewReplyInfo
+	ReviewReplyTemplate reviewReplyTemplate
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:               db,
-		ReviewAppealInfo: q.ReviewAppealInfo.clone(db),
-		ReviewInfo:       q.ReviewInfo.clone(db),
-		ReviewReplyInfo:  q.ReviewReplyInfo.clone(db),
+		db:                  db,
+		ReviewAppealInfo:    q.ReviewAppealInfo.clone(db),
+		ReviewAppendInfo:    q.ReviewAppendInfo.clone(db),
+		ReviewAutoReplyRule: q.ReviewAutoReplyRule.clone(db),
+		ReviewEventOutbox:   q.ReviewEventOutbox.clone(db),
+		ReviewHistory:       q.ReviewHistory.clone(db),
+		ReviewInfo:          q.ReviewInfo.clone(db),
+		ReviewOperationLog:  q.ReviewOperationLog.clone(db),
+		ReviewReplyInfo:     q.ReviewReplyInfo.clone(db),
+		ReviewReplyTemplate: q.ReviewReplyTemplate.clone(db),
 	}
 }
 
@@ -67,24 +97,42 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:               db,
-		ReviewAppealInfo: q.ReviewAppealInfo.replaceDB(db),
-		ReviewInfo:       q.ReviewInfo.replaceDB(db),
-		ReviewReplyInfo:  q.ReviewReplyInfo.replaceDB(db),
+		db:                  db,
+		ReviewAppealInfo:    q.ReviewAppealInfo.replaceDB(db),
+		ReviewAppendInfo:    q.ReviewAppendInfo.replaceDB(db),
+		ReviewAutoReplyRule: q.ReviewAutoReplyRule.replaceDB(db),
+		ReviewEventOutbox:   q.ReviewEventOutbox.replaceDB(db),
+		ReviewHistory:       q.ReviewHistory.replaceDB(db),
+		ReviewInfo:          q.ReviewInfo.replaceDB(db),
+		ReviewOperationLog:  q.ReviewOperationLog.replaceDB(db),
+		ReviewReplyInfo:     q.ReviewReplyInfo.replaceDB(db),
+		ReviewReplyTemplate: q.ReviewReplyTemplate.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	ReviewAppealInfo IReviewAppealInfoDo
-	ReviewInfo       IReviewInfoDo
-	ReviewReplyInfo  IReviewReplyInfoDo
+	ReviewAppealInfo    IReviewAppealInfoDo
+	ReviewAppendInfo    IReviewAppendInfoDo
+	ReviewAutoReplyRule IReviewAutoReplyRuleDo
+	ReviewEventOutbox   IReviewEventOutboxDo
+	ReviewHistory       IReviewHistoryDo
+	ReviewInfo          IReviewInfoDo
+	ReviewOperationLog  IReviewOperationLogDo
+	ReviewReplyInfo     IReviewReplyInfoDo
+	ReviewReplyTemplate IReviewReplyTemplateDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		ReviewAppealInfo: q.ReviewAppealInfo.WithContext(ctx),
-		ReviewInfo:       q.ReviewInfo.WithContext(ctx),
-		ReviewReplyInfo:  q.ReviewReplyInfo.WithContext(ctx),
+		ReviewAppealInfo:    q.ReviewAppealInfo.WithContext(ctx),
+		ReviewAppendInfo:    q.ReviewAppendInfo.WithContext(ctx),
+		ReviewAutoReplyRule: q.ReviewAutoReplyRule.WithContext(ctx),
+		ReviewEventOutbox:   q.ReviewEventOutbox.WithContext(ctx),
+		ReviewHistory:       q.ReviewHistory.WithContext(ctx),
+		ReviewInfo:          q.ReviewInfo.WithContext(ctx),
+		ReviewOperationLog:  q.ReviewOperationLog.WithContext(ctx),
+		ReviewReplyInfo:     q.ReviewReplyInfo.WithContext(ctx),
+		ReviewReplyTemplate: q.ReviewReplyTemplate.WithContext(ctx),
 	}
 }
 
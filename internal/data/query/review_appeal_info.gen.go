@@ -32,7 +32,7 @@ func newReviewAppealInfo(db *gorm.DB, opts ...gen.DOOption) reviewAppealInfo {
 	_reviewAppealInfo.UpdateBy = field.NewString(tableName, "update_by")
 	_reviewAppealInfo.CreateAt = field.NewTime(tableName, "create_at")
 	_reviewAppealInfo.UpdateAt = field.NewTime(tableName, "update_at")
-	_reviewAppealInfo.DeleteAt = field.NewTime(tableName, "delete_at")
+	_reviewAppealInfo.DeleteAt = field.NewField(tableName, "delete_at")
 	_reviewAppealInfo.Version = field.NewInt32(tableName, "version")
 	_reviewAppealInfo.AppealID = field.NewInt64(tableName, "appeal_id")
 	_reviewAppealInfo.ReviewID = field.NewInt64(tableName, "review_id")
@@ -42,6 +42,7 @@ func newReviewAppealInfo(db *gorm.DB, opts ...gen.DOOption) reviewAppealInfo {
 	_reviewAppealInfo.Content = field.NewString(tableName, "content")
 	_reviewAppealInfo.PicInfo = field.NewString(tableName, "pic_info")
 	_reviewAppealInfo.VideoInfo = field.NewString(tableName, "video_info")
+	_reviewAppealInfo.OpReason = field.NewString(tableName, "op_reason")
 	_reviewAppealInfo.OpRemarks = field.NewString(tableName, "op_remarks")
 	_reviewAppealInfo.OpUser = field.NewString(tableName, "op_user")
 	_reviewAppealInfo.ExtJSON = field.NewString(tableName, "ext_json")
@@ -56,26 +57,23 @@ func newReviewAppealInfo(db *gorm.DB, opts ...gen.DOOption) reviewAppealInfo {
 type reviewAppealInfo struct {
 	reviewAppealInfoDo reviewAppealInfoDo
 
-	ALL      field.Asterisk
-	ID       field.Int64  // 主键
-	CreateBy field.String // 创建⽅标识
-	UpdateBy field.String // 更新⽅标识
-	CreateAt field.Time   // 创建时间
-	UpdateAt field.Time   // 更新时间
-	DeleteAt field.Time   // 逻辑删除标记
-	Version  field.Int32  // 乐观锁标记
-	AppealID field.Int64  // 回复id
-	ReviewID field.Int64  // 评价id
-	StoreID  field.Int64  // 店铺id
-	/*
-		状态:10待审核；20申诉通过；30申诉
-		        驳回
-	*/
-	Status    field.Int32
+	ALL       field.Asterisk
+	ID        field.Int64  // 主键
+	CreateBy  field.String // 创建⽅标识
+	UpdateBy  field.String // 更新⽅标识
+	CreateAt  field.Time   // 创建时间
+	UpdateAt  field.Time   // 更新时间
+	DeleteAt  field.Field  // 逻辑删除标记
+	Version   field.Int32  // 乐观锁标记
+	AppealID  field.Int64  // 回复id
+	ReviewID  field.Int64  // 评价id
+	StoreID   field.Int64  // 店铺id
+	Status    field.Int32  // 状态:10待审核；20申诉通过；30申诉驳回；40已撤回
 	Reason    field.String // 申诉原因类别
 	Content   field.String // 申诉内容描述
 	PicInfo   field.String // 媒体信息：图⽚
 	VideoInfo field.String // 媒体信息：视频
+	OpReason  field.String // 运营审核原因
 	OpRemarks field.String // 运营备注
 	OpUser    field.String // 运营者标识
 	ExtJSON   field.String // 信息扩展
@@ -101,7 +99,7 @@ func (r *reviewAppealInfo) updateTableName(table string) *reviewAppealInfo {
 	r.UpdateBy = field.NewString(table, "update_by")
 	r.CreateAt = field.NewTime(table, "create_at")
 	r.UpdateAt = field.NewTime(table, "update_at")
-	r.DeleteAt = field.NewTime(table, "delete_at")
+	r.DeleteAt = field.NewField(table, "delete_at")
 	r.Version = field.NewInt32(table, "version")
 	r.AppealID = field.NewInt64(table, "appeal_id")
 	r.ReviewID = field.NewInt64(table, "review_id")
@@ -111,6 +109,7 @@ func (r *reviewAppealInfo) updateTableName(table string) *reviewAppealInfo {
 	r.Content = field.NewString(table, "content")
 	r.PicInfo = field.NewString(table, "pic_info")
 	r.VideoInfo = field.NewString(table, "video_info")
+	r.OpReason = field.NewString(table, "op_reason")
 	r.OpRemarks = field.NewString(table, "op_remarks")
 	r.OpUser = field.NewString(table, "op_user")
 	r.ExtJSON = field.NewString(table, "ext_json")
@@ -143,7 +142,7 @@ func (r *reviewAppealInfo) GetFieldByName(fieldName string) (field.OrderExpr, bo
 }
 
 func (r *reviewAppealInfo) fillFieldMap() {
-	r.fieldMap = make(map[string]field.Expr, 19)
+	r.fieldMap = make(map[string]field.Expr, 20)
 	r.fieldMap["id"] = r.ID
 	r.fieldMap["create_by"] = r.CreateBy
 	r.fieldMap["update_by"] = r.UpdateBy
@@ -159,6 +158,7 @@ func (r *reviewAppealInfo) fillFieldMap() {
 	r.fieldMap["content"] = r.Content
 	r.fieldMap["pic_info"] = r.PicInfo
 	r.fieldMap["video_info"] = r.VideoInfo
+	r.fieldMap["op_reason"] = r.OpReason
 	r.fieldMap["op_remarks"] = r.OpRemarks
 	r.fieldMap["op_user"] = r.OpUser
 	r.fieldMap["ext_json"] = r.ExtJSON
@@ -32,7 +32,7 @@ func newReviewInfo(db *gorm.DB, opts ...gen.DOOption) reviewInfo {
 	_reviewInfo.UpdateBy = field.NewString(tableName, "update_by")
 	_reviewInfo.CreateAt = field.NewTime(tableName, "create_at")
 	_reviewInfo.UpdateAt = field.NewTime(tableName, "update_at")
-	_reviewInfo.DeleteAt = field.NewTime(tableName, "delete_at")
+	_reviewInfo.DeleteAt = field.NewField(tableName, "delete_at")
 	_reviewInfo.Version = field.NewInt32(tableName, "version")
 	_reviewInfo.ReviewID = field.NewInt64(tableName, "review_id")
 	_reviewInfo.Content = field.NewString(tableName, "content")
@@ -74,7 +74,7 @@ type reviewInfo struct {
 	UpdateBy       field.String // 更新⽅标识
 	CreateAt       field.Time   // 创建时间
 	UpdateAt       field.Time   // 更新时间
-	DeleteAt       field.Time   // 逻辑删除标记
+	DeleteAt       field.Field  // 逻辑删除标记
 	Version        field.Int32  // 乐观锁标记
 	ReviewID       field.Int64  // 评价id
 	Content        field.String // 评价内容
@@ -93,7 +93,7 @@ type reviewInfo struct {
 	VideoInfo      field.String // 媒体信息：视频
 	Status         field.Int32  // 状态:10待审核；20审核通过；30审核不通过；40隐藏
 	IsDefault      field.Int32  // 是否默认评价
-	HasReply       field.Int32  // 是否有商家回复:0⽆;1有
+	HasReply       field.Int32  // 是否有商家回复:0⽆;1有;2回复已撤回
 	OpReason       field.String // 运营审核拒绝原因
 	OpRemarks      field.String // 运营备注
 	OpUser         field.String // 运营者标识
@@ -121,7 +121,7 @@ func (r *reviewInfo) updateTableName(table string) *reviewInfo {
 	r.UpdateBy = field.NewString(table, "update_by")
 	r.CreateAt = field.NewTime(table, "create_at")
 	r.UpdateAt = field.NewTime(table, "update_at")
-	r.DeleteAt = field.NewTime(table, "delete_at")
+	r.DeleteAt = field.NewField(table, "delete_at")
 	r.Version = field.NewInt32(table, "version")
 	r.ReviewID = field.NewInt64(table, "review_id")
 	r.Content = field.NewString(table, "content")
@@ -2,20 +2,23 @@ package data
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	v1 "review-service/api/review/v1"
 	"review-service/internal/biz"
 	"review-service/internal/data/model"
 	"review-service/internal/data/query"
 	"review-service/pkg/snowflake"
 	"strconv"
-	"strings"
 	"time"
 
 	"golang.org/x/sync/singleflight"
 
 	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/sortorder"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
@@ -40,26 +43,431 @@ func (r *reviewRepo) SaveReview(ctx context.Context, review *model.ReviewInfo) (
 	err := r.data.query.ReviewInfo.
 		WithContext(ctx).
 		Save(review)
-	return review, err
+	// (order_id, sku_id)上有唯一索引，同一个商品并发评价时只有一个能成功
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return nil, v1.ErrorOrderReviewed("订单:%d的商品:%d已评价", review.OrderID, review.SkuID)
+	}
+	return review, dbError(err, nil)
+}
+
+// SaveReviews 在一个事务中批量创建评价
+func (r *reviewRepo) SaveReviews(ctx context.Context, reviews []*model.ReviewInfo) error {
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		return tx.ReviewInfo.
+			WithContext(ctx).
+			Create(reviews...)
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return v1.ErrorOrderReviewed("订单中有商品已评价")
+	}
+	return dbError(err, nil)
 }
 
 // GetReviewByOrderID 根据订单ID查询评价
+// 已删除的评价也要查出来：订单评价后删除不能再重新评价，只能恢复原评价，避免删掉差评重新刷评价
 func (r *reviewRepo) GetReviewByOrderID(ctx context.Context, orderID int64) ([]*model.ReviewInfo, error) {
-	return r.data.query.ReviewInfo.
+	reviews, err := r.data.query.ReviewInfo.
 		WithContext(ctx).
+		Unscoped().
 		Where(r.data.query.ReviewInfo.OrderID.Eq(orderID)).
 		Find()
+	return reviews, dbError(err, nil)
 }
 
 // GetReview 查询评价详情
 func (r *reviewRepo) GetReview(ctx context.Context, reviewID int64) (*model.ReviewInfo, error) {
-	return r.data.query.ReviewInfo.WithContext(ctx).
+	review, err := r.data.query.ReviewInfo.WithContext(ctx).
 		Where(r.data.query.ReviewInfo.ReviewID.Eq(reviewID)).
 		First()
+	if err != nil {
+		return nil, dbError(err, v1.ErrorReviewNotFound("评价:%d不存在", reviewID))
+	}
+	return review, nil
+}
+
+// GetDeletedReview 查询已删除的评价
+func (r *reviewRepo) GetDeletedReview(ctx context.Context, reviewID int64) (*model.ReviewInfo, error) {
+	review, err := r.data.query.ReviewInfo.
+		WithContext(ctx).
+		Unscoped().
+		Where(
+			r.data.query.ReviewInfo.ReviewID.Eq(reviewID),
+			r.data.query.ReviewInfo.DeleteAt.IsNotNull(),
+		).
+		First()
+	if err != nil {
+		return nil, dbError(err, v1.ErrorReviewNotFound("已删除的评价:%d不存在", reviewID))
+	}
+	return review, nil
+}
+
+// DeleteReview 逻辑删除评价，删除成功后从ES和缓存中移除
+func (r *reviewRepo) DeleteReview(ctx context.Context, review *model.ReviewInfo, param *biz.DeleteReviewParam) error {
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		// 查询条件会自动带上delete_at IS NULL，已经被删除的更新不到
+		info, err := tx.ReviewInfo.
+			WithContext(ctx).
+			Where(
+				tx.ReviewInfo.ReviewID.Eq(review.ReviewID),
+				tx.ReviewInfo.Version.Eq(review.Version),
+			).
+			Updates(map[string]interface{}{
+				"delete_at": time.Now(),
+				"update_by": operator(param),
+				"version":   gorm.Expr("version + 1"),
+			})
+		if err != nil {
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return v1.ErrorVersionConflict("评价:%d已被修改，请重试", review.ReviewID)
+		}
+		return addOperationLog(ctx, tx, &model.ReviewOperationLog{
+			ReviewID:   review.ReviewID,
+			TargetType: biz.OpTargetReview,
+			Action:     biz.OpActionDeleteReview,
+			Actor:      operator(param),
+			OldStatus:  review.Status,
+			NewStatus:  review.Status,
+		})
+	})
+	if err != nil {
+		return dbError(err, nil)
+	}
+	// ES由同步任务根据binlog更新，这里主动删除一次让评价立刻从列表中消失
+	// 数据库已经删除成功，ES和缓存失败只记录日志
+	if _, err := r.data.es.Delete("review", strconv.FormatInt(review.ReviewID, 10)).Do(ctx); err != nil {
+		r.log.WithContext(ctx).Errorf("DeleteReview delete es doc fail, reviewID:%d err:%v", review.ReviewID, err)
+	}
+	r.purgeReviewCache(ctx, review)
+	return nil
+}
+
+// RestoreReview 恢复已删除的评价，删除时ES中的文档已经被移除，恢复成功后重新写入ES
+func (r *reviewRepo) RestoreReview(ctx context.Context, review *model.ReviewInfo, param *biz.DeleteReviewParam) error {
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		info, err := tx.ReviewInfo.
+			WithContext(ctx).
+			Unscoped().
+			Where(
+				tx.ReviewInfo.ReviewID.Eq(review.ReviewID),
+				tx.ReviewInfo.DeleteAt.IsNotNull(),
+				tx.ReviewInfo.Version.Eq(review.Version),
+			).
+			Updates(map[string]interface{}{
+				"delete_at": nil,
+				"update_by": operator(param),
+				"version":   gorm.Expr("version + 1"),
+			})
+		if err != nil {
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return v1.ErrorVersionConflict("评价:%d已被修改，请重试", review.ReviewID)
+		}
+		return addOperationLog(ctx, tx, &model.ReviewOperationLog{
+			ReviewID:   review.ReviewID,
+			TargetType: biz.OpTargetReview,
+			Action:     biz.OpActionRestoreReview,
+			Actor:      operator(param),
+			OldStatus:  review.Status,
+			NewStatus:  review.Status,
+		})
+	})
+	if err != nil {
+		return dbError(err, nil)
+	}
+	// 数据库已经恢复成功，写ES失败只记录日志，后续同步任务更新这条评价时会再写入
+	restored := *review
+	restored.DeleteAt = gorm.DeletedAt{}
+	restored.UpdateBy = operator(param)
+	restored.Version++
+	if err := r.indexReview(ctx, &restored); err != nil {
+		r.log.WithContext(ctx).Errorf("RestoreReview index es doc fail, reviewID:%d err:%v", review.ReviewID, err)
+	}
+	r.purgeReviewCache(ctx, review)
+	return nil
+}
+
+// indexReview 把评价完整写入ES，文档格式和同步任务写入的保持一致，审核通过的追评一并写入
+func (r *reviewRepo) indexReview(ctx context.Context, review *model.ReviewInfo) error {
+	appendInfo, err := r.GetAppendByReviewID(ctx, review.ReviewID)
+	if err != nil {
+		return err
+	}
+	doc := newReviewDoc(review)
+	if appendInfo != nil && appendInfo.Status == biz.AppendStatusApproved {
+		doc.Append = &biz.AppendDoc{
+			AppendID:  appendInfo.AppendID,
+			Content:   appendInfo.Content,
+			PicInfo:   appendInfo.PicInfo,
+			VideoInfo: appendInfo.VideoInfo,
+			CreateAt:  biz.MyTime(appendInfo.CreateAt),
+		}
+	}
+	_, err = r.data.es.Index("review").Id(strconv.FormatInt(review.ReviewID, 10)).Document(doc).Do(ctx)
+	return err
+}
+
+// newReviewDoc 数据库中的评价转换成ES文档，数值字段和同步任务一样按字符串写入
+func newReviewDoc(review *model.ReviewInfo) *biz.MyReviewInfo {
+	return &biz.MyReviewInfo{
+		ReviewInfo:   review,
+		CreateAt:     biz.MyTime(review.CreateAt),
+		UpdateAt:     biz.MyTime(review.UpdateAt),
+		Anonymous:    review.Anonymous,
+		Score:        review.Score,
+		ServiceScore: review.ServiceScore,
+		ExpressScore: review.ExpressScore,
+		HasMedia:     review.HasMedia,
+		Status:       review.Status,
+		IsDefault:    review.IsDefault,
+		HasReply:     review.HasReply,
+		ID:           review.ID,
+		Version:      review.Version,
+		ReviewID:     review.ReviewID,
+		OrderID:      review.OrderID,
+		SkuID:        review.SkuID,
+		SpuID:        review.SpuID,
+		StoreID:      review.StoreID,
+		UserID:       review.UserID,
+	}
+}
+
+// UpdateReview 用户修改评价，修改前的内容写入修改历史表，评价状态更新为重新审核之后的状态
+func (r *reviewRepo) UpdateReview(ctx context.Context, review *model.ReviewInfo, param *biz.UpdateReviewParam) error {
+	hasMedia := 0
+	if param.PicInfo != "" || param.VideoInfo != "" {
+		hasMedia = 1
+	}
+	updateBy := strconv.FormatInt(param.UserID, 10)
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		// 商家回复时会先更新has_reply，这里带上没有回复的条件，保证商家已回复的评价不会被修改
+		info, err := tx.ReviewInfo.
+			WithContext(ctx).
+			Where(
+				tx.ReviewInfo.ReviewID.Eq(review.ReviewID),
+				tx.ReviewInfo.HasReply.Neq(biz.ReplyStateReplied),
+				tx.ReviewInfo.Version.Eq(review.Version),
+			).
+			Updates(map[string]interface{}{
+				"score":         param.Score,
+				"service_score": param.ServiceScore,
+				"express_score": param.ExpressScore,
+				"content":       param.Content,
+				"pic_info":      param.PicInfo,
+				"video_info":    param.VideoInfo,
+				"has_media":     hasMedia,
+				"status":        param.Status,
+				"ctrl_json":     param.CtrlJSON,
+				"op_reason":     "",
+				"update_by":     updateBy,
+				"version":       gorm.Expr("version + 1"),
+			})
+		if err != nil {
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return v1.ErrorVersionConflict("评价:%d已被修改，请重试", review.ReviewID)
+		}
+		err = tx.ReviewHistory.
+			WithContext(ctx).
+			Create(&model.ReviewHistory{
+				CreateBy:      updateBy,
+				UpdateBy:      updateBy,
+				ReviewID:      review.ReviewID,
+				ReviewVersion: review.Version,
+				Content:       review.Content,
+				Score:         review.Score,
+				ServiceScore:  review.ServiceScore,
+				ExpressScore:  review.ExpressScore,
+				PicInfo:       review.PicInfo,
+				VideoInfo:     review.VideoInfo,
+				Status:        review.Status,
+			})
+		if err != nil {
+			return err
+		}
+		return addOperationLog(ctx, tx, &model.ReviewOperationLog{
+			ReviewID:   review.ReviewID,
+			TargetType: biz.OpTargetReview,
+			Action:     biz.OpActionUpdateReview,
+			Actor:      updateBy,
+			OldStatus:  review.Status,
+			NewStatus:  param.Status,
+		})
+	})
+	if err != nil {
+		return dbError(err, nil)
+	}
+	// 修改后评价重新审核，列表里不能再展示缓存中的旧内容
+	r.purgeReviewCache(ctx, review)
+	return nil
+}
+
+// SaveAppend 保存追评
+func (r *reviewRepo) SaveAppend(ctx context.Context, appendInfo *model.ReviewAppendInfo) (*model.ReviewAppendInfo, error) {
+	err := r.data.query.ReviewAppendInfo.
+		WithContext(ctx).
+		Create(appendInfo)
+	// review_id上有唯一索引，并发追评时只有一个能成功
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return nil, v1.ErrorAppendExists("评价:%d已追评", appendInfo.ReviewID)
+	}
+	return appendInfo, dbError(err, nil)
+}
+
+// GetAppendByReviewID 根据评价ID查询追评，没有追评时返回nil
+func (r *reviewRepo) GetAppendByReviewID(ctx context.Context, reviewID int64) (*model.ReviewAppendInfo, error) {
+	appendInfo, err := r.data.query.ReviewAppendInfo.
+		WithContext(ctx).
+		Where(r.data.query.ReviewAppendInfo.ReviewID.Eq(reviewID)).
+		First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return appendInfo, dbError(err, nil)
+}
+
+// ListAppendByReviewIDs 根据评价ID批量查询追评
+func (r *reviewRepo) ListAppendByReviewIDs(ctx context.Context, reviewIDs []int64) ([]*model.ReviewAppendInfo, error) {
+	if len(reviewIDs) == 0 {
+		return nil, nil
+	}
+	list, err := r.data.query.ReviewAppendInfo.
+		WithContext(ctx).
+		Where(r.data.query.ReviewAppendInfo.ReviewID.In(reviewIDs...)).
+		Find()
+	return list, dbError(err, nil)
+}
+
+// AuditAppend 审核追评，审核通过后把追评写入ES中评价的文档
+func (r *reviewRepo) AuditAppend(ctx context.Context, review *model.ReviewInfo, appendInfo *model.ReviewAppendInfo, param *biz.AuditAppendParam) error {
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		info, err := tx.ReviewAppendInfo.
+			WithContext(ctx).
+			Where(
+				tx.ReviewAppendInfo.AppendID.Eq(appendInfo.AppendID),
+				tx.ReviewAppendInfo.Version.Eq(appendInfo.Version),
+			).
+			Updates(map[string]interface{}{
+				"status":     param.Status,
+				"op_user":    param.OpUser,
+				"op_reason":  param.OpReason,
+				"op_remarks": param.OpRemarks,
+				"version":    gorm.Expr("version + 1"),
+			})
+		if err != nil {
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return v1.ErrorVersionConflict("追评:%d已被修改，请重试", appendInfo.AppendID)
+		}
+		return addOperationLog(ctx, tx, &model.ReviewOperationLog{
+			ReviewID:   review.ReviewID,
+			TargetType: biz.OpTargetAppend,
+			Action:     biz.OpActionAuditAppend,
+			Actor:      param.OpUser,
+			OldStatus:  appendInfo.Status,
+			NewStatus:  param.Status,
+			Reason:     param.OpReason,
+			Remarks:    param.OpRemarks,
+		})
+	})
+	if err != nil {
+		return dbError(err, nil)
+	}
+	if param.Status != biz.AppendStatusApproved {
+		return nil
+	}
+	// 只更新评价文档的append字段，评价本身的字段仍然由同步任务维护
+	// 数据库已经更新成功，写ES失败只记录日志，不影响审核结果
+	doc := map[string]interface{}{
+		"append": &biz.AppendDoc{
+			AppendID:  appendInfo.AppendID,
+			Content:   appendInfo.Content,
+			PicInfo:   appendInfo.PicInfo,
+			VideoInfo: appendInfo.VideoInfo,
+			CreateAt:  biz.MyTime(appendInfo.CreateAt),
+		},
+	}
+	if _, err := r.data.es.Update("review", strconv.FormatInt(review.ReviewID, 10)).Doc(doc).Do(ctx); err != nil {
+		r.log.WithContext(ctx).Errorf("AuditAppend update es doc fail, reviewID:%d err:%v", review.ReviewID, err)
+	}
+	r.purgeReviewCache(ctx, review)
+	return nil
+}
+
+// ListReviewHistory 查询评价的修改历史，最近修改的在前面
+func (r *reviewRepo) ListReviewHistory(ctx context.Context, reviewID int64) ([]*model.ReviewHistory, error) {
+	list, err := r.data.query.ReviewHistory.
+		WithContext(ctx).
+		Where(r.data.query.ReviewHistory.ReviewID.Eq(reviewID)).
+		Order(r.data.query.ReviewHistory.ID.Desc()).
+		Find()
+	return list, dbError(err, nil)
+}
+
+// operator 删除/恢复评价的操作人，运营优先
+func operator(param *biz.DeleteReviewParam) string {
+	if param.OpUser != "" {
+		return param.OpUser
+	}
+	return strconv.FormatInt(param.UserID, 10)
+}
+
+// purgeReviewCache 删除评价所在的店铺和商品列表的缓存
+// 缓存key是 前缀:查询条件的md5，没办法精确定位，只能按前缀把这个店铺和商品的缓存都删掉
+func (r *reviewRepo) purgeReviewCache(ctx context.Context, review *model.ReviewInfo) {
+	patterns := []string{
+		fmt.Sprintf("review:store:%d:*", review.StoreID),
+		fmt.Sprintf("review:spu:%d:0:*", review.SpuID),
+		fmt.Sprintf("review:spu:%d:%d:*", review.SpuID, review.SkuID),
+	}
+	for _, pattern := range patterns {
+		iter := r.data.rdb.Scan(ctx, 0, pattern, 100).Iterator()
+		for iter.Next(ctx) {
+			if err := r.data.rdb.Del(ctx, iter.Val()).Err(); err != nil {
+				r.log.WithContext(ctx).Errorf("purgeReviewCache del fail, key:%s err:%v", iter.Val(), err)
+			}
+		}
+		if err := iter.Err(); err != nil {
+			r.log.WithContext(ctx).Errorf("purgeReviewCache scan fail, pattern:%s err:%v", pattern, err)
+		}
+	}
+}
+
+// ListReviewByIDs 根据评价ID批量查询评价
+func (r *reviewRepo) ListReviewByIDs(ctx context.Context, reviewIDs []int64) ([]*model.ReviewInfo, error) {
+	reviews, err := r.data.query.ReviewInfo.
+		WithContext(ctx).
+		Where(r.data.query.ReviewInfo.ReviewID.In(reviewIDs...)).
+		Find()
+	return reviews, dbError(err, nil)
+}
+
+// ListReviewByOrderIDs 根据订单ID批量查询评价
+func (r *reviewRepo) ListReviewByOrderIDs(ctx context.Context, orderIDs []int64) ([]*model.ReviewInfo, error) {
+	reviews, err := r.data.query.ReviewInfo.
+		WithContext(ctx).
+		Where(r.data.query.ReviewInfo.OrderID.In(orderIDs...)).
+		Find()
+	return reviews, dbError(err, nil)
+}
+
+// ListReviewByOrderIDsWithDeleted 根据订单ID批量查询评价，包括已删除的评价
+func (r *reviewRepo) ListReviewByOrderIDsWithDeleted(ctx context.Context, orderIDs []int64) ([]*model.ReviewInfo, error) {
+	reviews, err := r.data.query.ReviewInfo.
+		WithContext(ctx).
+		Unscoped().
+		Where(r.data.query.ReviewInfo.OrderID.In(orderIDs...)).
+		Find()
+	return reviews, dbError(err, nil)
 }
 
 // SaveReply 保存评价回复
-func (r *reviewRepo) SaveReply(ctx context.Context, reply *model.ReviewReplyInfo) (*model.ReviewReplyInfo, error) {
+// 自动回复只回复从来没有回复过的评价，商家撤回回复后只能由商家手动重新回复
+func (r *reviewRepo) SaveReply(ctx context.Context, reply *model.ReviewReplyInfo, auto bool) (*model.ReviewReplyInfo, error) {
 	// 1. 数据校验
 	// 1.1 数据合法性校验（已回复的评价不允许商家再次回复）
 	// 先用评价ID查库,看下是否已回复
@@ -68,135 +476,527 @@ func (r *reviewRepo) SaveReply(ctx context.Context, reply *model.ReviewReplyInfo
 		Where(r.data.query.ReviewInfo.ReviewID.Eq(reply.ReviewID)).
 		First()
 	if err != nil {
-		return nil, err
+		return nil, dbError(err, v1.ErrorReviewNotFound("评价:%d不存在", reply.ReviewID))
 	}
-	if review.HasReply == 1 {
-		return nil, errors.New("该评价已回复")
+	// 可以回复的has_reply状态
+	replyable := []int32{biz.ReplyStateNone, biz.ReplyStateWithdrawn}
+	if auto {
+		replyable = []int32{biz.ReplyStateNone}
+	}
+	if !containsInt32(replyable, review.HasReply) {
+		return nil, v1.ErrorReplyExists("评价:%d已回复", reply.ReviewID)
 	}
 	// 1.2 水平越权校验（A商家只能回复自己的不能回复B商家的）
 	// 举例子：用户A删除订单，userID + orderID 当条件去查询订单然后删除
-	fmt.Println(review.StoreID, "和", reply.StoreID)
 	if review.StoreID != reply.StoreID {
-		return nil, errors.New("水平越权")
+		return nil, v1.ErrorStoreForbidden("店铺:%d无权操作评价:%d", reply.StoreID, reply.ReviewID)
 	}
 
 	// 2. 更新数据库中的数据（评价回复表和评价表要同时更新，涉及到事务操作）
 	// 事务操作
 	err = r.data.query.Transaction(func(tx *query.Query) error {
-		// 回复表插入一条数据
-		if err := tx.ReviewReplyInfo.
+		// 先把评价表的hasReply从没有回复（或者回复已撤回）改成1，这条UPDATE会锁住评价这一行，
+		// 并发回复时只有一个请求能更新成功，保证一条评价只有一条回复
+		info, err := tx.ReviewInfo.
 			WithContext(ctx).
-			Save(reply); err != nil {
-			r.log.WithContext(ctx).Errorf("SaveReply create reply fail, err:%v", err)
+			Where(
+				tx.ReviewInfo.ReviewID.Eq(reply.ReviewID),
+				tx.ReviewInfo.HasReply.In(replyable...),
+				tx.ReviewInfo.Version.Eq(review.Version),
+			).
+			Updates(map[string]interface{}{
+				"has_reply": biz.ReplyStateReplied,
+				"version":   gorm.Expr("version + 1"),
+			})
+		if err != nil {
+			r.log.WithContext(ctx).Errorf("SaveReply update review fail, err:%v", err)
 			return err
 		}
-		// 评价表更新hasReply字段
-		if _, err := tx.ReviewInfo.
+		if info.RowsAffected == 0 {
+			// 区分是已经被回复了还是评价被其他操作修改了
+			latest, err := tx.ReviewInfo.
+				WithContext(ctx).
+				Where(tx.ReviewInfo.ReviewID.Eq(reply.ReviewID)).
+				First()
+			if err != nil {
+				return err
+			}
+			if !containsInt32(replyable, latest.HasReply) {
+				return v1.ErrorReplyExists("评价:%d已回复", reply.ReviewID)
+			}
+			return v1.ErrorVersionConflict("评价:%d已被修改，请重试", reply.ReviewID)
+		}
+		// 回复表插入一条数据
+		if err := tx.ReviewReplyInfo.
 			WithContext(ctx).
-			Where(tx.ReviewInfo.ReviewID.Eq(reply.ReviewID)).
-			Update(tx.ReviewInfo.HasReply, 1); err != nil {
-			r.log.WithContext(ctx).Errorf("SaveReply update review fail, err:%v", err)
+			Create(reply); err != nil {
+			r.log.WithContext(ctx).Errorf("SaveReply create reply fail, err:%v", err)
 			return err
 		}
 		return nil
 	})
+	if err != nil {
+		return nil, dbError(err, nil)
+	}
 	// 3. 返回
-	return reply, err
+	return reply, nil
 }
 
-// AppealReview 保存申述内容
-func (r *reviewRepo) AppealReview(ctx context.Context, param *biz.AppealParam) (*model.ReviewAppealInfo, error) {
-	//1.先查询有没有申述
-	ret, err := r.data.query.ReviewAppealInfo.WithContext(ctx).
+func containsInt32(list []int32, v int32) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// GetReply 根据回复ID查询回复
+func (r *reviewRepo) GetReply(ctx context.Context, replyID int64) (*model.ReviewReplyInfo, error) {
+	reply, err := r.data.query.ReviewReplyInfo.
+		WithContext(ctx).
+		Where(r.data.query.ReviewReplyInfo.ReplyID.Eq(replyID)).
+		First()
+	if err != nil {
+		return nil, dbError(err, v1.ErrorReplyNotFound("回复:%d不存在", replyID))
+	}
+	return reply, nil
+}
+
+// UpdateReply 修改回复的内容
+func (r *reviewRepo) UpdateReply(ctx context.Context, reply *model.ReviewReplyInfo, updated *model.ReviewReplyInfo) error {
+	info, err := r.data.query.ReviewReplyInfo.
+		WithContext(ctx).
 		Where(
-			query.ReviewAppealInfo.ReviewID.Eq(param.ReviewID),
-			query.ReviewAppealInfo.StoreID.Eq(param.StoreID),
-		).First()
-	r.log.Debugf("AppealReview query ret:%v err:%v", ret, err)
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		//其他查询错误
-		return nil, err
+			r.data.query.ReviewReplyInfo.ReplyID.Eq(reply.ReplyID),
+			r.data.query.ReviewReplyInfo.Version.Eq(reply.Version),
+		).
+		Updates(map[string]interface{}{
+			"content":    updated.Content,
+			"pic_info":   updated.PicInfo,
+			"video_info": updated.VideoInfo,
+			"ctrl_json":  updated.CtrlJSON,
+			"update_by":  strconv.FormatInt(reply.StoreID, 10),
+			"version":    gorm.Expr("version + 1"),
+		})
+	if err != nil {
+		return dbError(err, nil)
+	}
+	if info.RowsAffected == 0 {
+		return v1.ErrorVersionConflict("回复:%d已被修改，请重试", reply.ReplyID)
+	}
+	r.purgeReplyCache(ctx, reply.ReviewID)
+	return nil
+}
+
+// DeleteReply 逻辑删除回复，同时把评价的has_reply改成回复已撤回，商家可以重新回复
+// 不改回0，保留评价回复过的标记，避免自动回复任务把它当成没有回复的评价再次回复
+func (r *reviewRepo) DeleteReply(ctx context.Context, reply *model.ReviewReplyInfo) error {
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		info, err := tx.ReviewReplyInfo.
+			WithContext(ctx).
+			Where(
+				tx.ReviewReplyInfo.ReplyID.Eq(reply.ReplyID),
+				tx.ReviewReplyInfo.Version.Eq(reply.Version),
+			).
+			Updates(map[string]interface{}{
+				"delete_at": time.Now(),
+				"update_by": strconv.FormatInt(reply.StoreID, 10),
+				"version":   gorm.Expr("version + 1"),
+			})
+		if err != nil {
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return v1.ErrorVersionConflict("回复:%d已被修改，请重试", reply.ReplyID)
+		}
+		_, err = tx.ReviewInfo.
+			WithContext(ctx).
+			Where(
+				tx.ReviewInfo.ReviewID.Eq(reply.ReviewID),
+				tx.ReviewInfo.HasReply.Eq(biz.ReplyStateReplied),
+			).
+			Updates(map[string]interface{}{
+				"has_reply": biz.ReplyStateWithdrawn,
+				"version":   gorm.Expr("version + 1"),
+			})
+		return err
+	})
+	if err != nil {
+		return dbError(err, nil)
+	}
+	r.purgeReplyCache(ctx, reply.ReviewID)
+	return nil
+}
+
+// purgeReplyCache 回复修改后删除评价所在列表的缓存
+func (r *reviewRepo) purgeReplyCache(ctx context.Context, reviewID int64) {
+	review, err := r.data.query.ReviewInfo.
+		WithContext(ctx).
+		Where(r.data.query.ReviewInfo.ReviewID.Eq(reviewID)).
+		First()
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("purgeReplyCache query review fail, reviewID:%d err:%v", reviewID, err)
+		return
 	}
+	r.purgeReviewCache(ctx, review)
+}
 
-	//已经有了申述评价
-	if err == nil && ret.Status > 10 {
-		return nil, errors.New("该评价已有审核过的申述记录")
+// ListReplyByStoreID 根据店铺ID分页查询回复（走idx_store_id索引）
+func (r *reviewRepo) ListReplyByStoreID(ctx context.Context, storeID int64, offset, limit int) ([]*model.ReviewReplyInfo, int64, error) {
+	replies, total, err := r.data.query.ReviewReplyInfo.
+		WithContext(ctx).
+		Where(r.data.query.ReviewReplyInfo.StoreID.Eq(storeID)).
+		Order(r.data.query.ReviewReplyInfo.ID.Desc()).
+		FindByPage(offset, limit)
+	if err != nil {
+		return nil, 0, dbError(err, nil)
 	}
+	return replies, total, nil
+}
 
-	// 2查询不到审核过的申诉记录
-	// 1. 有申诉记录但是处于待审核状态，需要更新
-	// if ret != nil{
-	// 	// update
-	// }else{
-	// 	// insert
-	// }
-	// 3. 没有申诉记录，需要创建
+// AuditReview 审核评价
+// 只更新仍处于待审核状态的评价，并用乐观锁防止并发审核时后一次审核覆盖前一次的结果
+func (r *reviewRepo) AuditReview(ctx context.Context, param *biz.AuditParam) error {
+	review, err := r.data.query.ReviewInfo.
+		WithContext(ctx).
+		Where(r.data.query.ReviewInfo.ReviewID.Eq(param.ReviewID)).
+		First()
+	if err != nil {
+		return dbError(err, v1.ErrorReviewNotFound("评价:%d不存在", param.ReviewID))
+	}
+	if review.Status != biz.ReviewStatusPending {
+		return v1.ErrorReviewStatusInvalid("评价:%d不是待审核状态", param.ReviewID)
+	}
+	err = r.data.query.Transaction(func(tx *query.Query) error {
+		info, err := tx.ReviewInfo.
+			WithContext(ctx).
+			Where(
+				tx.ReviewInfo.ReviewID.Eq(param.ReviewID),
+				tx.ReviewInfo.Version.Eq(review.Version),
+			).
+			Updates(map[string]interface{}{
+				"status":     param.Status,
+				"op_user":    param.OpUser,
+				"op_reason":  param.OpReason,
+				"op_remarks": param.OpRemarks,
+				"version":    gorm.Expr("version + 1"),
+			})
+		if err != nil {
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return v1.ErrorVersionConflict("评价:%d已被修改，请重试", param.ReviewID)
+		}
+		return addOperationLog(ctx, tx, &model.ReviewOperationLog{
+			ReviewID:   param.ReviewID,
+			TargetType: biz.OpTargetReview,
+			Action:     biz.OpActionAuditReview,
+			Actor:      param.OpUser,
+			OldStatus:  review.Status,
+			NewStatus:  param.Status,
+			Reason:     param.OpReason,
+			Remarks:    param.OpRemarks,
+		})
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("AuditReview update review fail, err:%v", err)
+		return dbError(err, nil)
+	}
+	return nil
+}
 
+// GetAppeal 根据申诉ID查询申诉
+func (r *reviewRepo) GetAppeal(ctx context.Context, appealID int64) (*model.ReviewAppealInfo, error) {
+	appeal, err := r.data.query.ReviewAppealInfo.
+		WithContext(ctx).
+		Where(r.data.query.ReviewAppealInfo.AppealID.Eq(appealID)).
+		First()
+	if err != nil {
+		return nil, dbError(err, v1.ErrorAppealNotFound("申诉:%d不存在", appealID))
+	}
+	return appeal, nil
+}
+
+// GetAppealByReviewID 根据评价ID查询最近一次申诉，没有申诉记录时返回nil
+func (r *reviewRepo) GetAppealByReviewID(ctx context.Context, reviewID int64) (*model.ReviewAppealInfo, error) {
+	appeal, err := r.data.query.ReviewAppealInfo.
+		WithContext(ctx).
+		Where(r.data.query.ReviewAppealInfo.ReviewID.Eq(reviewID)).
+		Order(r.data.query.ReviewAppealInfo.ID.Desc()).
+		First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return appeal, dbError(err, nil)
+}
+
+// AppealReview 保存申述内容，每次提交都新增一条申诉记录，之前的申诉作为历史保留
+// 申诉状态的校验在biz层通过状态机完成，这里在事务中再校验一次最近的申诉，防止并发提交
+func (r *reviewRepo) AppealReview(ctx context.Context, param *biz.AppealParam) (*model.ReviewAppealInfo, error) {
 	appeal := &model.ReviewAppealInfo{
+		AppealID:  snowflake.GenID(),
 		ReviewID:  param.ReviewID,
 		StoreID:   param.StoreID,
-		Status:    10,
+		Status:    biz.AppealStatusMachine.Initial(),
 		Reason:    param.Reason,
 		Content:   param.Content,
 		PicInfo:   param.PicInfo,
 		VideoInfo: param.VideoInfo,
+		CtrlJSON:  param.CtrlJSON,
 	}
-	if ret != nil {
-		appeal.AppealID = ret.AppealID
-	} else {
-		appeal.AppealID = snowflake.GenID()
-	}
-	err = r.data.query.ReviewAppealInfo.
-		WithContext(ctx).
-		Clauses(clause.OnConflict{
-			Columns: []clause.Column{
-				{Name: "review_id"}, // ON DUPLICATE KEY
-			},
-			DoUpdates: clause.Assignments(map[string]interface{}{ // UPDATE
-				"status":     appeal.Status,
-				"content":    appeal.Content,
-				"reason":     appeal.Reason,
-				"pic_info":   appeal.PicInfo,
-				"video_info": appeal.VideoInfo,
-			}),
-		}).
-		Create(appeal) // INSERT
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		// 1. 锁住评价这一行，同一条评价的申诉串行提交，保证最多只有一条待审核的申诉
+		_, err := tx.ReviewInfo.
+			WithContext(ctx).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where(tx.ReviewInfo.ReviewID.Eq(param.ReviewID)).
+			First()
+		if err != nil {
+			return dbError(err, v1.ErrorReviewNotFound("评价:%d不存在", param.ReviewID))
+		}
+		// 2. 最近一次申诉还在待审核时撤回，已审核的申诉不能再提交
+		last, err := tx.ReviewAppealInfo.
+			WithContext(ctx).
+			Where(tx.ReviewAppealInfo.ReviewID.Eq(param.ReviewID)).
+			Order(tx.ReviewAppealInfo.ID.Desc()).
+			First()
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if last != nil {
+			switch last.Status {
+			case biz.AppealStatusPending:
+				if err := withdrawAppeal(ctx, tx, last, appealActor(param), "重新提交申诉"); err != nil {
+					return err
+				}
+			case biz.AppealStatusWithdrawn:
+			default:
+				return v1.ErrorAppealStatusInvalid("评价:%d的申诉已审核，不能再申诉", param.ReviewID)
+			}
+		}
+		// 3. 新增申诉记录
+		if err := tx.ReviewAppealInfo.WithContext(ctx).Create(appeal); err != nil { // INSERT
+			return err
+		}
+		return addOperationLog(ctx, tx, appealLog(appeal.AppealID, 0, param))
+	})
 	r.log.Debugf("AppealReview, err:%v", err)
-	return appeal, err
+	if err != nil {
+		return nil, dbError(err, nil)
+	}
+	return appeal, nil
+}
+
+// appealActor 提交申诉的操作人，没有指定时使用店铺ID
+func appealActor(param *biz.AppealParam) string {
+	if param.OpUser != "" {
+		return param.OpUser
+	}
+	return strconv.FormatInt(param.StoreID, 10)
+}
 
+// appealLog 商家提交申诉的操作日志，oldStatus为0表示新建申诉
+func appealLog(appealID int64, oldStatus int32, param *biz.AppealParam) *model.ReviewOperationLog {
+	return &model.ReviewOperationLog{
+		ReviewID:   param.ReviewID,
+		AppealID:   appealID,
+		TargetType: biz.OpTargetAppeal,
+		Action:     biz.OpActionAppeal,
+		Actor:      appealActor(param),
+		OldStatus:  oldStatus,
+		NewStatus:  biz.AppealStatusMachine.Initial(),
+		Reason:     param.Reason,
+	}
 }
 
 // AduitAppeal AuditAppeal 审核申诉（运营对商家的申诉进行审核，审核通过会隐藏该评价）
-func (r *reviewRepo) AuditAppeal(ctx context.Context, param *biz.AuditAppealParam) error {
-	fmt.Println("这里出现错误2")
+func (r *reviewRepo) AuditAppeal(ctx context.Context, param *biz.AuditAppealParam, event *biz.Event) error {
 	err := r.data.query.Transaction(func(tx *query.Query) error {
-		// 申诉表
-		if _, err := tx.ReviewAppealInfo.
+		// 申诉表（只更新属于该评价、待审核的申诉）
+		appeal, err := tx.ReviewAppealInfo.
+			WithContext(ctx).
+			Where(tx.ReviewAppealInfo.AppealID.Eq(param.AppealID)).
+			First()
+		if err != nil {
+			return dbError(err, v1.ErrorAppealNotFound("申诉:%d不存在", param.AppealID))
+		}
+		if appeal.ReviewID != param.ReviewID {
+			return v1.ErrorParamInvalid("申诉:%d不属于评价:%d", param.AppealID, param.ReviewID)
+		}
+		if appeal.Status != biz.AppealStatusPending {
+			return v1.ErrorAppealStatusInvalid("申诉:%d不是待审核状态", param.AppealID)
+		}
+		info, err := tx.ReviewAppealInfo.
 			WithContext(ctx).
-			Where(r.data.query.ReviewAppealInfo.AppealID.Eq(param.AppealID)).
+			Where(
+				tx.ReviewAppealInfo.AppealID.Eq(param.AppealID),
+				tx.ReviewAppealInfo.Status.Eq(biz.AppealStatusPending),
+				tx.ReviewAppealInfo.Version.Eq(appeal.Version),
+			).
 			Updates(map[string]interface{}{
-				"status":  param.Status,
-				"op_user": param.OpUser,
-			}); err != nil {
+				"status":     param.Status,
+				"op_user":    param.OpUser,
+				"op_reason":  param.OpReason,
+				"op_remarks": param.OpRemarks,
+				"update_by":  param.OpUser,
+				"version":    gorm.Expr("version + 1"),
+			})
+		if err != nil {
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return v1.ErrorVersionConflict("申诉:%d已被修改，请重试", param.AppealID)
+		}
+		err = addOperationLog(ctx, tx, &model.ReviewOperationLog{
+			ReviewID:   param.ReviewID,
+			AppealID:   param.AppealID,
+			TargetType: biz.OpTargetAppeal,
+			Action:     biz.OpActionAuditAppeal,
+			Actor:      param.OpUser,
+			OldStatus:  appeal.Status,
+			NewStatus:  param.Status,
+			Reason:     param.OpReason,
+			Remarks:    param.OpRemarks,
+		})
+		if err != nil {
+			return err
+		}
+		// 审核结果通知写入发件箱，和审核结果一起提交或回滚
+		if err := addOutboxEvent(ctx, tx, event); err != nil {
 			return err
 		}
 		// 评价表
-		if param.Status == 20 { // 申诉通过则需要隐藏评价
-			if _, err := tx.ReviewInfo.WithContext(ctx).
+		if param.Status == biz.AppealStatusApproved { // 申诉通过则需要隐藏评价
+			review, err := tx.ReviewInfo.
+				WithContext(ctx).
 				Where(tx.ReviewInfo.ReviewID.Eq(param.ReviewID)).
-				Update(tx.ReviewInfo.Status, 40); err != nil {
+				First()
+			if err != nil {
+				return dbError(err, v1.ErrorReviewNotFound("评价:%d不存在", param.ReviewID))
+			}
+			if review.Status != biz.ReviewStatusApproved {
+				return v1.ErrorReviewStatusInvalid("评价:%d不是审核通过状态", param.ReviewID)
+			}
+			info, err := tx.ReviewInfo.
+				WithContext(ctx).
+				Where(
+					tx.ReviewInfo.ReviewID.Eq(param.ReviewID),
+					tx.ReviewInfo.Version.Eq(review.Version),
+				).
+				Updates(map[string]interface{}{
+					"status":  biz.ReviewStatusHidden,
+					"version": gorm.Expr("version + 1"),
+				})
+			if err != nil {
 				return err
 			}
+			if info.RowsAffected == 0 {
+				return v1.ErrorVersionConflict("评价:%d已被修改，请重试", param.ReviewID)
+			}
+			return addOperationLog(ctx, tx, &model.ReviewOperationLog{
+				ReviewID:   param.ReviewID,
+				AppealID:   param.AppealID,
+				TargetType: biz.OpTargetReview,
+				Action:     biz.OpActionHideReview,
+				Actor:      param.OpUser,
+				OldStatus:  review.Status,
+				NewStatus:  biz.ReviewStatusHidden,
+			})
 		}
 		return nil
 	})
-	return err
+	return dbError(err, nil)
 }
 
 // ListReviewByStoreID 根据storeID 分页查询评价
-func (r *reviewRepo) ListReviewByStoreID(ctx context.Context, storeID int64, offset, limit int) ([]*biz.MyReviewInfo, error) {
-	return r.getData2(ctx, storeID, offset, limit) //直接查询ES
-	//return r.getData2(ctx, storeID, offset, limit)
+func (r *reviewRepo) ListReviewByStoreID(ctx context.Context, param *biz.ListReviewParam, offset, limit int) ([]*biz.MyReviewInfo, int64, error) {
+	search := &esSearch{
+		Query: reviewQuery(param),
+		Sort:  reviewSort(param.Sort),
+		From:  offset,
+		Size:  limit,
+	}
+	page, err := r.getData2(ctx, fmt.Sprintf("review:store:%d", param.StoreID), search)
+	if err != nil {
+		return nil, 0, err
+	}
+	return page.list, page.total, nil
+}
+
+// ListReviewByUserID 根据userID分页查询评价（走idx_user_id索引，直接查MySQL保证用户能马上看到自己刚发布的评价）
+func (r *reviewRepo) ListReviewByUserID(ctx context.Context, userID int64, offset, limit int) ([]*model.ReviewInfo, int64, error) {
+	reviews, total, err := r.data.query.ReviewInfo.
+		WithContext(ctx).
+		Where(r.data.query.ReviewInfo.UserID.Eq(userID)).
+		Order(r.data.query.ReviewInfo.ID.Desc()).
+		FindByPage(offset, limit)
+	if err != nil {
+		return nil, 0, dbError(err, nil)
+	}
+	return reviews, total, nil
+}
+
+// ListReplyByReviewIDs 根据评价ID批量查询商家回复
+func (r *reviewRepo) ListReplyByReviewIDs(ctx context.Context, reviewIDs []int64) ([]*model.ReviewReplyInfo, error) {
+	if len(reviewIDs) == 0 {
+		return nil, nil
+	}
+	replies, err := r.data.query.ReviewReplyInfo.
+		WithContext(ctx).
+		Where(r.data.query.ReviewReplyInfo.ReviewID.In(reviewIDs...)).
+		Find()
+	return replies, dbError(err, nil)
+}
+
+// ListPendingReviews 按创建顺序分页查询待审核的评价（走idx_status索引）
+func (r *reviewRepo) ListPendingReviews(ctx context.Context, offset, limit int) ([]*model.ReviewInfo, int64, error) {
+	reviews, total, err := r.data.query.ReviewInfo.
+		WithContext(ctx).
+		Where(r.data.query.ReviewInfo.Status.Eq(biz.ReviewStatusPending)).
+		Order(r.data.query.ReviewInfo.ID).
+		FindByPage(offset, limit)
+	if err != nil {
+		return nil, 0, dbError(err, nil)
+	}
+	return reviews, total, nil
+}
+
+// ListPendingAppeals 按创建顺序分页查询待审核的申诉（走idx_status索引）
+func (r *reviewRepo) ListPendingAppeals(ctx context.Context, offset, limit int) ([]*model.ReviewAppealInfo, int64, error) {
+	appeals, total, err := r.data.query.ReviewAppealInfo.
+		WithContext(ctx).
+		Where(r.data.query.ReviewAppealInfo.Status.Eq(biz.AppealStatusPending)).
+		Order(r.data.query.ReviewAppealInfo.ID).
+		FindByPage(offset, limit)
+	if err != nil {
+		return nil, 0, dbError(err, nil)
+	}
+	return appeals, total, nil
+}
+
+// ListReviewBySpu 根据spu/sku查询评价，使用search_after游标翻页，避免深度分页
+func (r *reviewRepo) ListReviewBySpu(ctx context.Context, param *biz.ListReviewParam, cursor string, limit int) (*biz.ReviewPage, error) {
+	after, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, v1.ErrorParamInvalid("无效的游标:%s", cursor)
+	}
+	search := &esSearch{
+		Query:       reviewQuery(param),
+		Sort:        reviewSort(biz.ReviewSortNewest),
+		Size:        limit,
+		SearchAfter: after,
+	}
+	page, err := r.getData2(ctx, fmt.Sprintf("review:spu:%d:%d", param.SpuID, param.SkuID), search)
+	if err != nil {
+		return nil, err
+	}
+	ret := &biz.ReviewPage{List: page.list, Total: page.total}
+	// 取满一页才可能有下一页
+	if n := len(page.list); n > 0 && n == limit {
+		ret.NextCursor = encodeCursor(page.list[n-1])
+	}
+	return ret, nil
 }
 
 // getdata1
@@ -220,7 +1020,7 @@ func (r *reviewRepo) getdata1(ctx context.Context, storeID int64, offset, limit
 		Do(ctx)
 	fmt.Printf("--> es search: %v %v\n", resp, err)
 	if err != nil {
-		return nil, err
+		return nil, searchError(err)
 	}
 	fmt.Printf("es result total:%v\n", resp.Hits.Total.Value)
 
@@ -244,16 +1044,39 @@ func (r *reviewRepo) getdata1(ctx context.Context, storeID int64, offset, limit
 
 var g singleflight.Group
 
+// esSearch ES的查询条件
+// 序列化之后的结果同时用来拼接缓存key，保证不同的筛选条件不会命中同一份缓存
+type esSearch struct {
+	Query       *types.Query             `json:"query"`
+	Sort        []types.SortCombinations `json:"sort"`
+	From        int                      `json:"from"`
+	Size        int                      `json:"size"`
+	SearchAfter []types.FieldValue       `json:"search_after,omitempty"`
+}
+
+// esPage ES查询到的一页数据
+type esPage struct {
+	list  []*biz.MyReviewInfo
+	total int64
+}
+
 // getData2升级后带有缓存版本的查询函数
-func (r *reviewRepo) getData2(ctx context.Context, storeID int64, offset, limit int) ([]*biz.MyReviewInfo, error) {
+// prefix是缓存key的前缀，比如 review:store:76089
+func (r *reviewRepo) getData2(ctx context.Context, prefix string, search *esSearch) (*esPage, error) {
 	//取数据
 	//1.先查询redis缓存
 	//2 缓存没有查询es
 	//3 通过singleflight合并短时间大量的并发请求
 
-	//拼接key
-	key := fmt.Sprintf("review:%d:%d:%d", storeID, offset, limit)
-	b, err := r.getDataBySingleflight(ctx, key)
+	//拼接key，查询条件比较多，取md5避免key过长
+	b, err := json.Marshal(search)
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("%s:%x", prefix, md5.Sum(b))
+	b, err = r.getDataBySingleflight(ctx, key, func(ctx context.Context) ([]byte, error) {
+		return r.getDataFromES(ctx, search)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -266,8 +1089,7 @@ func (r *reviewRepo) getData2(ctx context.Context, storeID int64, offset, limit
 	// 反序列化
 	// 反序列化数据
 	// resp.Hits.Hits[0].Source_(json.RawMessage)  ==>  model.ReviewInfo
-	list := make([]*biz.MyReviewInfo, 0, hm.Total.Value) // ?
-	// list := make([]*model.ReviewInfo)                           // ?
+	list := make([]*biz.MyReviewInfo, 0, len(hm.Hits))
 
 	for _, hit := range hm.Hits {
 		tmp := &biz.MyReviewInfo{}
@@ -277,13 +1099,17 @@ func (r *reviewRepo) getData2(ctx context.Context, storeID int64, offset, limit
 		}
 		list = append(list, tmp)
 	}
-	return list, nil
+	page := &esPage{list: list}
+	if hm.Total != nil {
+		page.total = hm.Total.Value
+	}
+	return page, nil
 }
 
-// key review:76089:1:10  --> "[{},{},{}]"
+// key review:store:76089:<md5>  --> "[{},{},{}]"
 // json.Unmarshal([]byte)
 
-func (r *reviewRepo) getDataBySingleflight(ctx context.Context, key string) ([]byte, error) {
+func (r *reviewRepo) getDataBySingleflight(ctx context.Context, key string, fetch func(context.Context) ([]byte, error)) ([]byte, error) {
 	v, err, shared := g.Do(key, func() (interface{}, error) {
 		// 查缓存
 		data, err := r.getDataFromCache(ctx, key)
@@ -294,15 +1120,18 @@ func (r *reviewRepo) getDataBySingleflight(ctx context.Context, key string) ([]b
 		// 只有在缓存中没有这个key的错误时才查ES
 		if errors.Is(err, redis.Nil) {
 			// 缓存中没有这个key,说明缓存失效了，需要查ES
-			data, err := r.getDataFromES(ctx, key)
-			if err == nil {
-				// 设置缓存
-				return data, r.setCache(ctx, key, data)
+			data, err := fetch(ctx)
+			if err != nil {
+				return nil, searchError(err)
+			}
+			// 设置缓存，设置失败不影响本次查询结果
+			if err := r.setCache(ctx, key, data); err != nil {
+				r.log.WithContext(ctx).Errorf("setCache fail, key:%s err:%v", key, err)
 			}
-			return nil, err
+			return data, nil
 		}
 		// 查缓存失败了,直接返回错误，不继续向下传导压力
-		return nil, err
+		return nil, cacheError(err)
 	})
 	r.log.Debugf("singleflight ret: v:%v err:%v shared:%v\n", v, err, shared)
 	if err != nil {
@@ -311,7 +1140,7 @@ func (r *reviewRepo) getDataBySingleflight(ctx context.Context, key string) ([]b
 	return v.([]byte), nil
 }
 
-// key review:76089:1:10  --> "[{},{},{}]"
+// key review:store:76089:<md5>  --> "[{},{},{}]"
 // json.Unmarshal([]byte)   因为es中查询出来的是这个类型，让redis中返回的也是这个类型，这样序列化时，不管是从哪里查询出来的数据都能直接反序列化
 // 读取缓存
 func (r *reviewRepo) getDataFromCache(ctx context.Context, key string) ([]byte, error) { //这里返回字节类型字符串
@@ -324,45 +1153,141 @@ func (r *reviewRepo) setCache(ctx context.Context, key string, data []byte) erro
 	return r.data.rdb.Set(ctx, key, data, time.Second*10).Err()
 }
 
-// getDataFroms 从es中查询
-func (r *reviewRepo) getDataFromES(ctx context.Context, key string) ([]byte, error) {
-	//分割key从key中获取到page size 等
-	values := strings.Split(key, ":")
-	if len(values) < 4 {
-		return nil, errors.New("invalid key")
+// getDataFromES 从es中查询
+func (r *reviewRepo) getDataFromES(ctx context.Context, search *esSearch) ([]byte, error) {
+	req := r.data.es.Search().
+		Index("review").
+		Query(search.Query).
+		Sort(search.Sort...).
+		From(search.From).
+		Size(search.Size).
+		TrackTotalHits(true) // 默认最多只统计10000条，需要返回准确的总数
+	if len(search.SearchAfter) > 0 {
+		req = req.SearchAfter(search.SearchAfter...)
 	}
-
-	//格式转换
-	index, storeID, offsetStr, limitStr := values[0], values[1], values[2], values[3]
-
-	offset, err := strconv.Atoi(offsetStr)
+	resp, err := req.Do(ctx)
 	if err != nil {
 		return nil, err
 	}
-	limit, err := strconv.Atoi(limitStr)
+
+	//将查询到的数据序列化到resp.HitS结构体中
+	return json.Marshal(resp.Hits)
+}
+
+// reviewQuery 根据查询评价列表的参数构建ES查询条件
+func reviewQuery(param *biz.ListReviewParam) *types.Query {
+	filter := make([]types.Query, 0, 8)
+	if param.StoreID > 0 {
+		filter = append(filter, termQuery("store_id", param.StoreID))
+	}
+	if param.SpuID > 0 {
+		filter = append(filter, termQuery("spu_id", param.SpuID))
+	}
+	if param.SkuID > 0 {
+		filter = append(filter, termQuery("sku_id", param.SkuID))
+	}
+	if param.HasMedia != nil {
+		filter = append(filter, termQuery("has_media", *param.HasMedia))
+	}
+	// 排除已逻辑删除的评价
+	mustNot := []types.Query{{Exists: &types.ExistsQuery{Field: "delete_at"}}}
+	if param.HasReply != nil {
+		// 回复已撤回的评价按没有回复处理
+		if *param.HasReply == biz.ReplyStateReplied {
+			filter = append(filter, termQuery("has_reply", biz.ReplyStateReplied))
+		} else {
+			mustNot = append(mustNot, termQuery("has_reply", biz.ReplyStateReplied))
+		}
+	}
+	if param.Status != nil {
+		filter = append(filter, termQuery("status", *param.Status))
+	}
+	if param.Anonymous != nil {
+		filter = append(filter, termQuery("anonymous", *param.Anonymous))
+	}
+	if param.HasContent != nil {
+		isDefault := int32(0)
+		if *param.HasContent == 0 {
+			isDefault = 1
+		}
+		filter = append(filter, termQuery("is_default", isDefault))
+	}
+	if param.MinScore > 0 || param.MaxScore > 0 {
+		score := types.NumberRangeQuery{}
+		if param.MinScore > 0 {
+			minScore := types.Float64(param.MinScore)
+			score.Gte = &minScore
+		}
+		if param.MaxScore > 0 {
+			maxScore := types.Float64(param.MaxScore)
+			score.Lte = &maxScore
+		}
+		filter = append(filter, types.Query{Range: map[string]types.RangeQuery{"score": score}})
+	}
+	if !param.StartTime.IsZero() || !param.EndTime.IsZero() {
+		// ES中create_at保存的是MySQL同步过来的 2006-01-02 15:04:05 格式
+		format := "yyyy-MM-dd HH:mm:ss"
+		createAt := types.DateRangeQuery{Format: &format}
+		if !param.StartTime.IsZero() {
+			start := param.StartTime.Format(time.DateTime)
+			createAt.Gte = &start
+		}
+		if !param.EndTime.IsZero() {
+			end := param.EndTime.Format(time.DateTime)
+			createAt.Lte = &end
+		}
+		filter = append(filter, types.Query{Range: map[string]types.RangeQuery{"create_at": createAt}})
+	}
+	return &types.Query{Bool: &types.BoolQuery{Filter: filter, MustNot: mustNot}}
+}
+
+// termQuery 精确匹配某个字段
+func termQuery(field string, value interface{}) types.Query {
+	return types.Query{
+		Term: map[string]types.TermQuery{
+			field: {Value: value},
+		},
+	}
+}
+
+// reviewSort 评价列表的排序条件，最后按review_id排序保证分页结果稳定
+func reviewSort(sort int32) []types.SortCombinations {
+	field, order := "create_at", sortorder.Desc
+	switch sort {
+	case biz.ReviewSortOldest:
+		order = sortorder.Asc
+	case biz.ReviewSortScoreAsc:
+		field, order = "score", sortorder.Asc
+	case biz.ReviewSortScoreDesc:
+		field, order = "score", sortorder.Desc
+	}
+	return []types.SortCombinations{
+		map[string]types.FieldSort{field: {Order: &order}},
+		map[string]types.FieldSort{"review_id": {Order: &order}},
+	}
+}
+
+// 游标的内容是上一页最后一条评价的排序值：create_at（毫秒时间戳）和review_id
+// 没有直接使用ES返回的hit.sort，因为反序列化成float64之后review_id会丢失精度
+
+// encodeCursor 根据一页中最后一条评价生成下一页的游标
+func encodeCursor(last *biz.MyReviewInfo) string {
+	s := fmt.Sprintf("%d_%d", time.Time(last.CreateAt).UnixMilli(), last.ReviewID)
+	return base64.RawURLEncoding.EncodeToString([]byte(s))
+}
+
+// decodeCursor 解析游标，得到ES search_after的参数，游标为空表示第一页
+func decodeCursor(cursor string) ([]types.FieldValue, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := r.data.es.Search().
-		Index(index).
-		From(offset).
-		Size(limit).
-		Query(&types.Query{
-			Bool: &types.BoolQuery{
-				Filter: []types.Query{
-					{
-						Term: map[string]types.TermQuery{
-							"store_id": {Value: storeID},
-						},
-					},
-				},
-			},
-		}).
-		Do(ctx)
-	if err != nil {
+	var createAt, reviewID int64
+	if _, err := fmt.Sscanf(string(b), "%d_%d", &createAt, &reviewID); err != nil {
 		return nil, err
 	}
-
-	//将查询到的数据序列化到resp.HitS结构体中
-	return json.Marshal(resp.Hits)
+	return []types.FieldValue{createAt, reviewID}, nil
 }
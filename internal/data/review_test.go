@@ -0,0 +1,113 @@
+package data
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+	"testing"
+	"time"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		createAt time.Time
+		reviewID int64
+	}{
+		{"普通评价", time.UnixMilli(1700000000123), 1234567890},
+		{"雪花ID超过float64精度", time.UnixMilli(1700000000000), 1<<62 + 1},
+		{"零值", time.UnixMilli(0), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor := encodeCursor(&biz.MyReviewInfo{CreateAt: biz.MyTime(tt.createAt), ReviewID: tt.reviewID})
+			after, err := decodeCursor(cursor)
+			if err != nil {
+				t.Fatalf("decodeCursor(%q) unexpected error: %v", cursor, err)
+			}
+			if len(after) != 2 {
+				t.Fatalf("decodeCursor(%q) = %v, want 2 values", cursor, after)
+			}
+			if after[0] != tt.createAt.UnixMilli() || after[1] != tt.reviewID {
+				t.Fatalf("decodeCursor(%q) = %v, want [%d %d]", cursor, after, tt.createAt.UnixMilli(), tt.reviewID)
+			}
+		})
+	}
+}
+
+func TestDecodeCursor(t *testing.T) {
+	encode := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+	tests := []struct {
+		name    string
+		cursor  string
+		wantNil bool
+		wantErr bool
+	}{
+		{"空游标是第一页", "", true, false},
+		{"不是base64", "!!!", true, true},
+		{"标准base64填充", base64.StdEncoding.EncodeToString([]byte("1_23")), true, true},
+		{"不是数字", encode("abc_def"), true, true},
+		{"缺少review_id", encode("1700000000000_"), true, true},
+		{"缺少分隔符", encode("1700000000000"), true, true},
+		{"合法游标", encode("1700000000000_42"), false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			after, err := decodeCursor(tt.cursor)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("decodeCursor(%q) error = %v, wantErr %v", tt.cursor, err, tt.wantErr)
+			}
+			if (after == nil) != tt.wantNil {
+				t.Fatalf("decodeCursor(%q) = %v, wantNil %v", tt.cursor, after, tt.wantNil)
+			}
+		})
+	}
+}
+
+func TestNewReviewDoc(t *testing.T) {
+	review := &model.ReviewInfo{
+		ReviewID:  1<<62 + 1,
+		StoreID:   100,
+		Score:     5,
+		Status:    biz.ReviewStatusApproved,
+		Content:   "好评",
+		CreateAt:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.Local),
+		Anonymous: 1,
+	}
+	b, err := json.Marshal(newReviewDoc(review))
+	if err != nil {
+		t.Fatalf("marshal review doc fail: %v", err)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		t.Fatalf("unmarshal review doc fail: %v", err)
+	}
+	tests := []struct {
+		field string
+		want  interface{}
+	}{
+		{"review_id", "4611686018427387905"},
+		{"store_id", "100"},
+		{"score", "5"},
+		{"status", "20"},
+		{"anonymous", "1"},
+		{"content", "好评"},
+		{"create_at", "2024-01-02 03:04:05"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			if got := doc[tt.field]; got != tt.want {
+				t.Fatalf("doc[%q] = %#v, want %#v", tt.field, got, tt.want)
+			}
+		})
+	}
+	// 和同步任务写入的文档一样能解析回来
+	var info biz.MyReviewInfo
+	if err := json.Unmarshal(b, &info); err != nil {
+		t.Fatalf("unmarshal MyReviewInfo fail: %v", err)
+	}
+	if info.ReviewID != review.ReviewID || time.Time(info.CreateAt).Unix() != review.CreateAt.Unix() {
+		t.Fatalf("unmarshal MyReviewInfo = %d %v, want %d %v", info.ReviewID, time.Time(info.CreateAt), review.ReviewID, review.CreateAt)
+	}
+}
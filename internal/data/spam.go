@@ -0,0 +1,107 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"review-service/internal/biz"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// 指纹以"hash:reviewID"的格式保存在redis list中，最新的在最前面
+const (
+	userFingerprintKey  = "review:fp:user:%d"
+	storeFingerprintKey = "review:fp:store:%d"
+	userRateKey         = "review:rate:user:%d"
+)
+
+type spamRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSpamRepo .
+func NewSpamRepo(data *Data, logger log.Logger) biz.SpamRepo {
+	return &spamRepo{data: data, log: log.NewHelper(logger)}
+}
+
+// ListUserFingerprints 查询用户最近发布的评价指纹
+func (r *spamRepo) ListUserFingerprints(ctx context.Context, userID int64) ([]*biz.Fingerprint, error) {
+	return r.listFingerprints(ctx, fmt.Sprintf(userFingerprintKey, userID))
+}
+
+// ListStoreFingerprints 查询店铺最近收到的评价指纹
+func (r *spamRepo) ListStoreFingerprints(ctx context.Context, storeID int64) ([]*biz.Fingerprint, error) {
+	return r.listFingerprints(ctx, fmt.Sprintf(storeFingerprintKey, storeID))
+}
+
+func (r *spamRepo) listFingerprints(ctx context.Context, key string) ([]*biz.Fingerprint, error) {
+	vals, err := r.data.rdb.LRange(ctx, key, 0, -1).Result()
+	if err != nil {
+		return nil, cacheError(err)
+	}
+	ret := make([]*biz.Fingerprint, 0, len(vals))
+	for _, v := range vals {
+		hash, id, ok := strings.Cut(v, ":")
+		if !ok {
+			continue
+		}
+		h, err := strconv.ParseUint(hash, 16, 64)
+		if err != nil {
+			continue
+		}
+		reviewID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			continue
+		}
+		ret = append(ret, &biz.Fingerprint{ReviewID: reviewID, Hash: h})
+	}
+	return ret, nil
+}
+
+// SaveFingerprint 记录一条评价的指纹
+func (r *spamRepo) SaveFingerprint(ctx context.Context, userID, storeID int64, fp *biz.Fingerprint, size int, window time.Duration) error {
+	val := fmt.Sprintf("%016x:%d", fp.Hash, fp.ReviewID)
+	_, err := r.data.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, key := range []string{
+			fmt.Sprintf(userFingerprintKey, userID),
+			fmt.Sprintf(storeFingerprintKey, storeID),
+		} {
+			pipe.LPush(ctx, key, val)
+			pipe.LTrim(ctx, key, 0, int64(size-1))
+			pipe.Expire(ctx, key, window)
+		}
+		return nil
+	})
+	return cacheError(err)
+}
+
+// GetUserReviewCount 查询用户在当前时间窗口内发布的评价数
+func (r *spamRepo) GetUserReviewCount(ctx context.Context, userID int64) (int64, error) {
+	n, err := r.data.rdb.Get(ctx, fmt.Sprintf(userRateKey, userID)).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	return n, cacheError(err)
+}
+
+// incrScript 计数和设置过期时间在一个脚本中原子执行
+// 没有过期时间时（窗口内第一次发布）才设置，避免计数永不过期
+var incrScript = redis.NewScript(`
+local n = redis.call("INCRBY", KEYS[1], ARGV[1])
+if redis.call("PTTL", KEYS[1]) < 0 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return n
+`)
+
+// IncrUserReviewCount 增加用户在当前时间窗口内发布的评价数
+// 固定窗口计数：窗口内第一次发布时设置过期时间
+func (r *spamRepo) IncrUserReviewCount(ctx context.Context, userID int64, n int64, window time.Duration) error {
+	key := fmt.Sprintf(userRateKey, userID)
+	return cacheError(incrScript.Run(ctx, r.data.rdb, []string{key}, n, window.Milliseconds()).Err())
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+	"review-service/internal/biz"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// 网关鉴权之后写入的调用方身份请求头，外部请求带的同名请求头由网关覆盖
+const (
+	headerRole    = "x-md-global-role"
+	headerUserID  = "x-md-global-user-id"
+	headerStoreID = "x-md-global-store-id"
+	headerOpUser  = "x-md-global-op-user"
+)
+
+// roles 请求头中的角色 -> biz中的调用方角色
+var roles = map[string]int32{
+	"user":     biz.RoleUser,
+	"merchant": biz.RoleMerchant,
+	"operator": biz.RoleOperator,
+}
+
+// callerIdentity 从请求头解析调用方身份放到ctx中，解析不出来的按未登录的C端用户处理
+func callerIdentity() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				ctx = biz.NewCallerContext(ctx, parseCaller(tr.RequestHeader()))
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+func parseCaller(header transport.Header) *biz.Caller {
+	caller := &biz.Caller{Role: biz.RoleUser}
+	role, ok := roles[header.Get(headerRole)]
+	if !ok {
+		return caller
+	}
+	caller.Role = role
+	switch role {
+	case biz.RoleUser:
+		caller.UserID, _ = strconv.ParseInt(header.Get(headerUserID), 10, 64)
+	case biz.RoleMerchant:
+		caller.StoreID, _ = strconv.ParseInt(header.Get(headerStoreID), 10, 64)
+	case biz.RoleOperator:
+		caller.OpUser = header.Get(headerOpUser)
+	}
+	return caller
+}
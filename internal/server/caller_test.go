@@ -0,0 +1,43 @@
+package server
+
+import (
+	"review-service/internal/biz"
+	"testing"
+)
+
+// headerCarrier 测试用的请求头
+type headerCarrier map[string]string
+
+func (h headerCarrier) Get(key string) string      { return h[key] }
+func (h headerCarrier) Set(key, value string)      { h[key] = value }
+func (h headerCarrier) Add(key, value string)      { h[key] = value }
+func (h headerCarrier) Values(key string) []string { return []string{h[key]} }
+func (h headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func TestParseCaller(t *testing.T) {
+	tests := []struct {
+		name   string
+		header headerCarrier
+		want   biz.Caller
+	}{
+		{"没有身份", headerCarrier{}, biz.Caller{Role: biz.RoleUser}},
+		{"未知角色", headerCarrier{headerRole: "admin", headerOpUser: "op"}, biz.Caller{Role: biz.RoleUser}},
+		{"C端用户", headerCarrier{headerRole: "user", headerUserID: "123"}, biz.Caller{Role: biz.RoleUser, UserID: 123}},
+		{"C端用户ID不合法", headerCarrier{headerRole: "user", headerUserID: "abc"}, biz.Caller{Role: biz.RoleUser}},
+		{"商家", headerCarrier{headerRole: "merchant", headerStoreID: "456", headerOpUser: "op"}, biz.Caller{Role: biz.RoleMerchant, StoreID: 456}},
+		{"运营", headerCarrier{headerRole: "operator", headerOpUser: "op", headerStoreID: "456"}, biz.Caller{Role: biz.RoleOperator, OpUser: "op"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseCaller(tt.header); *got != tt.want {
+				t.Fatalf("parseCaller() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
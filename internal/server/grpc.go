@@ -17,6 +17,7 @@ func NewGRPCServer(c *conf.Server, review *service.ReviewService, logger log.Log
 		grpc.Middleware( //使用中间键
 			recovery.Recovery(),
 			validate.Validator(),
+			callerIdentity(),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -17,6 +17,7 @@ func NewHTTPServer(c *conf.Server, reviewer *service.ReviewService, logger log.L
 		http.Middleware(
 			recovery.Recovery(),
 			validate.Validator(),
+			callerIdentity(),
 		),
 	}
 	if c.Http.Network != "" {
@@ -0,0 +1,256 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// 没有配置时后台任务默认的扫描间隔
+const (
+	defaultReviewInterval     = 10 * time.Minute
+	defaultAutoReplyInterval  = 5 * time.Minute
+	defaultEventRelayInterval = 5 * time.Second
+)
+
+// defaultJobLeaseTTL 后台任务租约的有效期，执行期间每隔三分之一的有效期续约一次
+// 进程异常退出后最多经过这段时间其他副本就可以接手
+const defaultJobLeaseTTL = 30 * time.Second
+
+// 后台任务租约的任务类型，和审核任务共用LeaseRepo，任务ID固定为0
+const (
+	jobLeaseDefaultReview int32 = 101 // 默认好评任务
+	jobLeaseAutoReply     int32 = 102 // 自动回复任务
+	jobLeaseEventRelay    int32 = 103 // 发件箱事件发送任务
+)
+
+// periodicJob 定时执行的后台任务
+// 实现了transport.Server，和gRPC、HTTP服务一起在newApp中注册，随服务启动和停止
+type periodicJob struct {
+	name     string
+	enable   bool
+	interval time.Duration
+	run      func(ctx context.Context)
+	stop     chan struct{}
+	done     chan struct{}
+	log      *log.Helper
+
+	// 服务部署多个副本时每一轮只有拿到租约的副本执行，leases为nil时不加锁
+	leases    biz.LeaseRepo
+	leaseType int32
+	leaseTTL  time.Duration
+	owner     string
+}
+
+func newPeriodicJob(name string, enable bool, interval *durationpb.Duration, def time.Duration, logger log.Logger) *periodicJob {
+	job := &periodicJob{
+		name:     name,
+		enable:   enable,
+		interval: def,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+		log:      log.NewHelper(logger),
+	}
+	if interval != nil && interval.AsDuration() > 0 {
+		job.interval = interval.AsDuration()
+	}
+	return job
+}
+
+// withLease 每一轮执行前先领取租约，同一时间只有一个副本执行
+func (j *periodicJob) withLease(leases biz.LeaseRepo, leaseType int32) {
+	hostname, _ := os.Hostname()
+	j.leases = leases
+	j.leaseType = leaseType
+	j.leaseTTL = defaultJobLeaseTTL
+	j.owner = fmt.Sprintf("%s-%d", hostname, os.Getpid())
+}
+
+// runOnce 执行一轮任务，租约已被其他副本持有时跳过这一轮
+// 执行期间定时续约，执行时间超过租约有效期也不会被其他副本同时执行；执行结束后主动释放
+func (j *periodicJob) runOnce(ctx context.Context) {
+	if j.leases == nil {
+		j.run(ctx)
+		return
+	}
+	ok, err := j.leases.AcquireLease(ctx, j.leaseType, 0, j.owner, j.leaseTTL)
+	if err != nil {
+		j.log.Errorf("[job] %s acquire lease fail, err:%v", j.name, err)
+		return
+	}
+	if !ok {
+		j.log.Debugf("[job] %s is running on another replica, skip", j.name)
+		return
+	}
+	runCtx, cancel := context.WithCancel(ctx)
+	kept := make(chan struct{})
+	go func() {
+		defer close(kept)
+		j.keepLease(runCtx, cancel)
+	}()
+	defer func() {
+		cancel()
+		<-kept
+		if err := j.leases.ReleaseLease(ctx, j.leaseType, 0, j.owner); err != nil {
+			j.log.Errorf("[job] %s release lease fail, err:%v", j.name, err)
+		}
+	}()
+	j.run(runCtx)
+}
+
+// keepLease 任务执行期间定时续约，直到ctx被取消
+// 租约已经丢失，或者续约一直失败到租约快要过期时，取消正在执行的任务，避免和其他副本同时执行
+func (j *periodicJob) keepLease(ctx context.Context, cancel context.CancelFunc) {
+	ticker := time.NewTicker(j.leaseTTL / 3)
+	defer ticker.Stop()
+	renewAt := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		ok, err := j.leases.RenewLease(ctx, j.leaseType, 0, j.owner, j.leaseTTL)
+		if err == nil && ok {
+			renewAt = time.Now()
+			continue
+		}
+		if err == nil {
+			j.log.Errorf("[job] %s lease lost, cancel the running job", j.name)
+			cancel()
+			return
+		}
+		j.log.Errorf("[job] %s renew lease fail, err:%v", j.name, err)
+		if time.Since(renewAt) >= j.leaseTTL*2/3 {
+			j.log.Errorf("[job] %s lease is about to expire, cancel the running job", j.name)
+			cancel()
+			return
+		}
+	}
+}
+
+// Start 启动任务，每隔interval执行一次，直到Stop被调用
+func (j *periodicJob) Start(ctx context.Context) error {
+	defer close(j.done)
+	if !j.enable {
+		j.log.Infof("[job] %s job disabled", j.name)
+		return nil
+	}
+	j.log.Infof("[job] %s job start, interval:%v", j.name, j.interval)
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+	for {
+		j.runOnce(ctx)
+		select {
+		case <-ticker.C:
+		case <-j.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Stop 停止任务，等待正在执行的一轮结束
+func (j *periodicJob) Stop(ctx context.Context) error {
+	j.log.Infof("[job] %s job stopping", j.name)
+	close(j.stop)
+	select {
+	case <-j.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return nil
+}
+
+// DefaultReviewJob 默认好评后台任务
+type DefaultReviewJob struct {
+	*periodicJob
+	uc *biz.DefaultReviewUsecase
+}
+
+// NewDefaultReviewJob .
+func NewDefaultReviewJob(c *conf.Review, uc *biz.DefaultReviewUsecase, leases biz.LeaseRepo, logger log.Logger) *DefaultReviewJob {
+	cfg := c.GetDefaultReview()
+	job := &DefaultReviewJob{
+		periodicJob: newPeriodicJob("default review", cfg.GetEnable(), cfg.GetInterval(), defaultReviewInterval, logger),
+		uc:          uc,
+	}
+	job.periodicJob.run = job.run
+	job.withLease(leases, jobLeaseDefaultReview)
+	return job
+}
+
+func (j *DefaultReviewJob) run(ctx context.Context) {
+	n, err := j.uc.CreateDefaultReviews(ctx)
+	if err != nil {
+		j.log.Errorf("[job] CreateDefaultReviews fail, created:%d err:%v", n, err)
+		return
+	}
+	if n > 0 {
+		j.log.Infof("[job] CreateDefaultReviews created:%d", n)
+	}
+}
+
+// AutoReplyJob 商家自动回复后台任务
+type AutoReplyJob struct {
+	*periodicJob
+	uc *biz.AutoReplyUsecase
+}
+
+// NewAutoReplyJob .
+func NewAutoReplyJob(c *conf.Review, uc *biz.AutoReplyUsecase, leases biz.LeaseRepo, logger log.Logger) *AutoReplyJob {
+	cfg := c.GetAutoReply()
+	job := &AutoReplyJob{
+		periodicJob: newPeriodicJob("auto reply", cfg.GetEnable(), cfg.GetInterval(), defaultAutoReplyInterval, logger),
+		uc:          uc,
+	}
+	job.periodicJob.run = job.run
+	job.withLease(leases, jobLeaseAutoReply)
+	return job
+}
+
+func (j *AutoReplyJob) run(ctx context.Context) {
+	n, err := j.uc.RunAutoReply(ctx)
+	if err != nil {
+		j.log.Errorf("[job] RunAutoReply fail, replied:%d err:%v", n, err)
+		return
+	}
+	if n > 0 {
+		j.log.Infof("[job] RunAutoReply replied:%d", n)
+	}
+}
+
+// EventRelayJob 发送发件箱中业务事件的后台任务，事件通知依赖它，所以总是启用
+type EventRelayJob struct {
+	*periodicJob
+	relay *biz.EventRelay
+}
+
+// NewEventRelayJob .
+func NewEventRelayJob(c *conf.Review, relay *biz.EventRelay, leases biz.LeaseRepo, logger log.Logger) *EventRelayJob {
+	job := &EventRelayJob{
+		periodicJob: newPeriodicJob("event relay", true, c.GetEvent().GetRelayInterval(), defaultEventRelayInterval, logger),
+		relay:       relay,
+	}
+	job.periodicJob.run = job.run
+	job.withLease(leases, jobLeaseEventRelay)
+	return job
+}
+
+func (j *EventRelayJob) run(ctx context.Context) {
+	n, err := j.relay.RelayEvents(ctx)
+	if err != nil {
+		j.log.Errorf("[job] RelayEvents fail, sent:%d err:%v", n, err)
+		return
+	}
+	if n > 0 {
+		j.log.Debugf("[job] RelayEvents sent:%d", n)
+	}
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"review-service/internal/biz"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeLeaseRepo 测试用的租约，holder不为空表示租约已被其他副本持有，lost为true时续约失败
+type fakeLeaseRepo struct {
+	mu       sync.Mutex
+	holder   string
+	err      error
+	lost     bool
+	renewed  int
+	released bool
+}
+
+func (r *fakeLeaseRepo) AcquireLease(ctx context.Context, taskType int32, taskID int64, owner string, ttl time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return false, r.err
+	}
+	if r.holder != "" {
+		return false, nil
+	}
+	r.holder = owner
+	return true, nil
+}
+
+func (r *fakeLeaseRepo) GetLeases(ctx context.Context, taskType int32, taskIDs []int64) (map[int64]*biz.Lease, error) {
+	return nil, nil
+}
+
+func (r *fakeLeaseRepo) RenewLease(ctx context.Context, taskType int32, taskID int64, owner string, ttl time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.lost || r.holder != owner {
+		return false, nil
+	}
+	r.renewed++
+	return true, nil
+}
+
+func (r *fakeLeaseRepo) ReleaseLease(ctx context.Context, taskType int32, taskID int64, owner string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.holder == owner {
+		r.holder = ""
+		r.released = true
+	}
+	return nil
+}
+
+func TestPeriodicJobRunOnce(t *testing.T) {
+	tests := []struct {
+		name         string
+		leases       *fakeLeaseRepo
+		wantRun      bool
+		wantReleased bool
+	}{
+		{"没有配置租约", nil, true, false},
+		{"拿到租约", &fakeLeaseRepo{}, true, true},
+		{"租约被其他副本持有", &fakeLeaseRepo{holder: "other"}, false, false},
+		{"redis出错", &fakeLeaseRepo{err: errors.New("redis down")}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := newPeriodicJob("test", true, nil, time.Minute, log.DefaultLogger)
+			ran := false
+			job.run = func(ctx context.Context) { ran = true }
+			if tt.leases != nil {
+				job.withLease(tt.leases, jobLeaseDefaultReview)
+			}
+			job.runOnce(context.Background())
+			if ran != tt.wantRun {
+				t.Fatalf("runOnce() ran = %v, want %v", ran, tt.wantRun)
+			}
+			if tt.leases != nil && tt.leases.released != tt.wantReleased {
+				t.Fatalf("runOnce() released = %v, want %v", tt.leases.released, tt.wantReleased)
+			}
+		})
+	}
+}
+
+func TestPeriodicJobKeepLease(t *testing.T) {
+	tests := []struct {
+		name        string
+		leases      *fakeLeaseRepo
+		wantCancel  bool
+		wantRenewed bool
+	}{
+		{"执行时间超过租约有效期时续约", &fakeLeaseRepo{}, false, true},
+		{"租约丢失时取消执行", &fakeLeaseRepo{lost: true}, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := newPeriodicJob("test", true, nil, time.Minute, log.DefaultLogger)
+			job.withLease(tt.leases, jobLeaseDefaultReview)
+			job.leaseTTL = 30 * time.Millisecond
+			canceled := false
+			job.run = func(ctx context.Context) {
+				select {
+				case <-ctx.Done():
+					canceled = true
+				case <-time.After(100 * time.Millisecond):
+				}
+			}
+			job.runOnce(context.Background())
+			if canceled != tt.wantCancel {
+				t.Fatalf("runOnce() canceled = %v, want %v", canceled, tt.wantCancel)
+			}
+			if renewed := tt.leases.renewed > 0; renewed != tt.wantRenewed {
+				t.Fatalf("runOnce() renewed = %v, want %v", renewed, tt.wantRenewed)
+			}
+			if !tt.leases.released {
+				t.Fatalf("runOnce() lease not released")
+			}
+		})
+	}
+}
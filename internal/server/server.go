@@ -10,7 +10,7 @@ import (
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewRegistrar, NewGRPCServer, NewHTTPServer)
+var ProviderSet = wire.NewSet(NewRegistrar, NewGRPCServer, NewHTTPServer, NewDefaultReviewJob, NewAutoReplyJob, NewEventRelayJob)
 
 //服务注册是在创建服务的时候给注册上去的 ，所以要在创建服务的时候进行服务注册
 
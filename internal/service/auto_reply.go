@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+
+	pb "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+)
+
+// CreateReplyTemplate 商家创建回复模板
+func (s *ReviewService) CreateReplyTemplate(ctx context.Context, req *pb.CreateReplyTemplateRequest) (*pb.CreateReplyTemplateReply, error) {
+	tpl, err := s.autoReply.CreateTemplate(ctx, &biz.ReplyTemplateParam{
+		StoreID: req.GetStoreID(),
+		Name:    req.GetName(),
+		Content: req.GetContent(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateReplyTemplateReply{TemplateID: tpl.TemplateID}, nil
+}
+
+// UpdateReplyTemplate 商家修改回复模板
+func (s *ReviewService) UpdateReplyTemplate(ctx context.Context, req *pb.UpdateReplyTemplateRequest) (*pb.UpdateReplyTemplateReply, error) {
+	err := s.autoReply.UpdateTemplate(ctx, &biz.ReplyTemplateParam{
+		TemplateID: req.GetTemplateID(),
+		StoreID:    req.GetStoreID(),
+		Name:       req.GetName(),
+		Content:    req.GetContent(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateReplyTemplateReply{}, nil
+}
+
+// DeleteReplyTemplate 商家删除回复模板
+func (s *ReviewService) DeleteReplyTemplate(ctx context.Context, req *pb.DeleteReplyTemplateRequest) (*pb.DeleteReplyTemplateReply, error) {
+	if err := s.autoReply.DeleteTemplate(ctx, req.GetTemplateID(), req.GetStoreID()); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteReplyTemplateReply{}, nil
+}
+
+// ListReplyTemplates 查询店铺的回复模板
+func (s *ReviewService) ListReplyTemplates(ctx context.Context, req *pb.ListReplyTemplatesRequest) (*pb.ListReplyTemplatesReply, error) {
+	templates, err := s.autoReply.ListTemplates(ctx, req.GetStoreID())
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.ReplyTemplate, 0, len(templates))
+	for _, tpl := range templates {
+		list = append(list, &pb.ReplyTemplate{
+			TemplateID: tpl.TemplateID,
+			StoreID:    tpl.StoreID,
+			Name:       tpl.Name,
+			Content:    tpl.Content,
+			CreateAt:   tpl.CreateAt.Unix(),
+			UpdateAt:   tpl.UpdateAt.Unix(),
+		})
+	}
+	return &pb.ListReplyTemplatesReply{List: list}, nil
+}
+
+// CreateAutoReplyRule 商家创建自动回复规则
+func (s *ReviewService) CreateAutoReplyRule(ctx context.Context, req *pb.CreateAutoReplyRuleRequest) (*pb.CreateAutoReplyRuleReply, error) {
+	rule, err := s.autoReply.CreateRule(ctx, autoReplyRuleParam(req.GetRule()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateAutoReplyRuleReply{RuleID: rule.RuleID}, nil
+}
+
+// UpdateAutoReplyRule 商家修改自动回复规则
+func (s *ReviewService) UpdateAutoReplyRule(ctx context.Context, req *pb.UpdateAutoReplyRuleRequest) (*pb.UpdateAutoReplyRuleReply, error) {
+	if err := s.autoReply.UpdateRule(ctx, autoReplyRuleParam(req.GetRule())); err != nil {
+		return nil, err
+	}
+	return &pb.UpdateAutoReplyRuleReply{}, nil
+}
+
+// DeleteAutoReplyRule 商家删除自动回复规则
+func (s *ReviewService) DeleteAutoReplyRule(ctx context.Context, req *pb.DeleteAutoReplyRuleRequest) (*pb.DeleteAutoReplyRuleReply, error) {
+	if err := s.autoReply.DeleteRule(ctx, req.GetRuleID(), req.GetStoreID()); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteAutoReplyRuleReply{}, nil
+}
+
+// ListAutoReplyRules 查询店铺的自动回复规则
+func (s *ReviewService) ListAutoReplyRules(ctx context.Context, req *pb.ListAutoReplyRulesRequest) (*pb.ListAutoReplyRulesReply, error) {
+	rules, err := s.autoReply.ListRules(ctx, req.GetStoreID())
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.AutoReplyRule, 0, len(rules))
+	for _, rule := range rules {
+		list = append(list, autoReplyRuleFromModel(rule))
+	}
+	return &pb.ListAutoReplyRulesReply{List: list}, nil
+}
+
+// autoReplyRuleParam 将请求中的规则转换成biz层的参数
+func autoReplyRuleParam(r *pb.AutoReplyRule) *biz.AutoReplyRuleParam {
+	return &biz.AutoReplyRuleParam{
+		RuleID:      r.GetRuleID(),
+		StoreID:     r.GetStoreID(),
+		TemplateID:  r.GetTemplateID(),
+		MinScore:    r.GetMinScore(),
+		MaxScore:    r.GetMaxScore(),
+		ContentType: r.GetContentType(),
+		Delay:       r.GetDelay(),
+		Priority:    r.GetPriority(),
+		Enable:      r.GetEnable(),
+	}
+}
+
+// autoReplyRuleFromModel 将数据库中的规则转换成返回给调用方的结构
+func autoReplyRuleFromModel(r *model.ReviewAutoReplyRule) *pb.AutoReplyRule {
+	return &pb.AutoReplyRule{
+		RuleID:      r.RuleID,
+		StoreID:     r.StoreID,
+		TemplateID:  r.TemplateID,
+		MinScore:    r.MinScore,
+		MaxScore:    r.MaxScore,
+		ContentType: r.ContentType,
+		Delay:       r.Delay,
+		Priority:    r.Priority,
+		Enable:      r.Enable == 1,
+	}
+}
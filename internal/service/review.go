@@ -2,7 +2,7 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"time"
 
 	pb "review-service/api/review/v1"
 	"review-service/internal/biz"
@@ -12,18 +12,19 @@ import (
 type ReviewService struct {
 	pb.UnimplementedReviewServer
 
-	uc *biz.ReviewUsecase
+	uc        *biz.ReviewUsecase
+	autoReply *biz.AutoReplyUsecase
 }
 
-func NewReviewService(uc *biz.ReviewUsecase) *ReviewService {
+func NewReviewService(uc *biz.ReviewUsecase, autoReply *biz.AutoReplyUsecase) *ReviewService {
 	return &ReviewService{
-		uc: uc,
+		uc:        uc,
+		autoReply: autoReply,
 	}
 }
 
 // CreateReview 创建服务
 func (s *ReviewService) CreateReview(ctx context.Context, req *pb.CreateReviewRequest) (*pb.CreateReviewReply, error) {
-	fmt.Printf("[serviece] CreateReview Req:%#v", req)
 	//参数转化 该rpc方法请求体Request 转换为 reviewInfo
 	//调用biz层
 	var anonymous int32 //判断是不是匿名
@@ -39,8 +40,9 @@ func (s *ReviewService) CreateReview(ctx context.Context, req *pb.CreateReviewRe
 		PicInfo:      req.PicInfo,
 		VideoInfo:    req.VideoInfo,
 		Anonymous:    anonymous,
-		Status:       0,
 		StoreID:      req.StoreID,
+		SkuID:        req.SkuID,
+		SpuID:        req.SpuID,
 	})
 
 	//如果下一层出现了错误，这里review就是nil，防止空指针
@@ -53,31 +55,120 @@ func (s *ReviewService) CreateReview(ctx context.Context, req *pb.CreateReviewRe
 
 }
 
+// BatchCreateReviews 一次评价订单中的多个商品
+func (s *ReviewService) BatchCreateReviews(ctx context.Context, req *pb.BatchCreateReviewsRequest) (*pb.BatchCreateReviewsReply, error) {
+	reviews := make([]*model.ReviewInfo, 0, len(req.GetItems()))
+	for _, item := range req.GetItems() {
+		var anonymous int32
+		if item.GetAnonymous() {
+			anonymous = 1
+		}
+		reviews = append(reviews, &model.ReviewInfo{
+			UserID:       req.GetUserID(),
+			OrderID:      req.GetOrderID(),
+			StoreID:      req.GetStoreID(),
+			SkuID:        item.GetSkuID(),
+			SpuID:        item.GetSpuID(),
+			Score:        item.GetScore(),
+			ServiceScore: item.GetServiceScore(),
+			ExpressScore: item.GetExpressScore(),
+			Content:      item.GetContent(),
+			PicInfo:      item.GetPicInfo(),
+			VideoInfo:    item.GetVideoInfo(),
+			Anonymous:    anonymous,
+		})
+	}
+	reviews, err := s.uc.BatchCreateReviews(ctx, req.GetOrderID(), reviews)
+	if err != nil {
+		return nil, err
+	}
+	reviewIDs := make(map[int64]int64, len(reviews))
+	for _, review := range reviews {
+		reviewIDs[review.SkuID] = review.ReviewID
+	}
+	return &pb.BatchCreateReviewsReply{ReviewIDs: reviewIDs}, nil
+}
+
+// GetReviewByOrderID 查询订单的评价情况
+func (s *ReviewService) GetReviewByOrderID(ctx context.Context, req *pb.GetReviewByOrderIDRequest) (*pb.GetReviewByOrderIDReply, error) {
+	ret, err := s.uc.GetReviewByOrderID(ctx, req.GetOrderID(), req.GetSkuIDs())
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.ReviewInfo, 0, len(ret.Reviews))
+	for _, review := range ret.Reviews {
+		info := reviewInfoFromModel(review)
+		info.OpReason = review.OpReason
+		list = append(list, info)
+	}
+	return &pb.GetReviewByOrderIDReply{List: list, UnreviewedSkuIDs: ret.UnreviewedSkuIDs}, nil
+}
+
 // GetReview 获取评价详情
 func (s *ReviewService) GetReview(ctx context.Context, req *pb.GetReviewRequest) (*pb.GetReviewReply, error) {
-	review, err := s.uc.GetReview(ctx, req.GetReviewID())
+	// 调用方身份由中间件从网关的请求头解析，请求中的storeID和opUser不再作为鉴权依据
+	detail, err := s.uc.GetReview(ctx, &biz.GetReviewParam{ReviewID: req.GetReviewID()})
 	if err != nil {
 		return nil, err
 	}
 	//构建响应结构体
-	return &pb.GetReviewReply{
-		Data: &pb.ReviewInfo{
-			ReviewID:     review.ReviewID,
-			UserID:       review.UserID,
-			Score:        review.Score,
-			ServiceScore: review.ServiceScore,
-			ExpressScore: review.ExpressScore,
-			Content:      review.Content,
-			PicInfo:      review.PicInfo,
-			VideoInfo:    review.VideoInfo,
-			Status:       review.Status,
-		},
-	}, err
+	reply := &pb.GetReviewReply{Data: reviewInfoFromModel(detail.Review)}
+	if detail.ShowOpReason {
+		reply.Data.OpReason = detail.Review.OpReason
+	}
+	if detail.Reply != nil {
+		reply.Data.Reply = replyInfoFromModel(detail.Reply)
+	}
+	if detail.Appeal != nil {
+		reply.Appeal = appealInfoFromModel(detail.Appeal)
+	}
+	if detail.Append != nil {
+		reply.Data.Append = appendInfoFromModel(detail.Append)
+	}
+	return reply, nil
+}
+
+// BatchGetReviews 根据评价ID批量查询评价
+func (s *ReviewService) BatchGetReviews(ctx context.Context, req *pb.BatchGetReviewsRequest) (*pb.BatchGetReviewsReply, error) {
+	reviews, err := s.uc.BatchGetReviews(ctx, req.GetReviewIDs())
+	if err != nil {
+		return nil, err
+	}
+	// 请求的每个ID都返回一个结果，查不到的标记为found=false
+	items := make(map[int64]*pb.BatchReviewItem, len(req.GetReviewIDs()))
+	for _, id := range req.GetReviewIDs() {
+		item := &pb.BatchReviewItem{}
+		if review, ok := reviews[id]; ok {
+			item.Found = true
+			item.Data = reviewInfoFromModel(review)
+		}
+		items[id] = item
+	}
+	return &pb.BatchGetReviewsReply{Items: items}, nil
+}
+
+// BatchGetReviewsByOrderIDs 根据订单ID批量查询评价
+func (s *ReviewService) BatchGetReviewsByOrderIDs(ctx context.Context, req *pb.BatchGetReviewsByOrderIDsRequest) (*pb.BatchGetReviewsByOrderIDsReply, error) {
+	reviews, err := s.uc.BatchGetReviewsByOrderIDs(ctx, req.GetOrderIDs())
+	if err != nil {
+		return nil, err
+	}
+	items := make(map[int64]*pb.BatchOrderReviewItem, len(req.GetOrderIDs()))
+	for _, id := range req.GetOrderIDs() {
+		item := &pb.BatchOrderReviewItem{}
+		if list, ok := reviews[id]; ok {
+			item.Found = true
+			for _, review := range list {
+				item.List = append(item.List, reviewInfoFromModel(review))
+			}
+		}
+		items[id] = item
+	}
+	return &pb.BatchGetReviewsByOrderIDsReply{Items: items}, nil
 }
 
 // ReplyReview 商家回复评价
 func (s *ReviewService) ReplyReview(ctx context.Context, req *pb.ReplyReviewRequest) (*pb.ReplyReviewReply, error) {
-	fmt.Printf("[serviece] CreateReview Req:%#v", req)
 	//调用biz层
 	replyreview, err := s.uc.CreateReply(ctx, &biz.ReplyParam{
 		ReviewID:  req.ReviewID,
@@ -94,9 +185,240 @@ func (s *ReviewService) ReplyReview(ctx context.Context, req *pb.ReplyReviewRequ
 	return &pb.ReplyReviewReply{ReplyID: replyreview.ReplyID}, nil
 }
 
+// UpdateReply 商家修改回复
+func (s *ReviewService) UpdateReply(ctx context.Context, req *pb.UpdateReplyRequest) (*pb.UpdateReplyReply, error) {
+	reply, err := s.uc.UpdateReply(ctx, &biz.UpdateReplyParam{
+		ReplyID:   req.GetReplyID(),
+		StoreID:   req.GetStoreID(),
+		Content:   req.GetContent(),
+		PicInfo:   req.GetPicInfo(),
+		VideoInfo: req.GetVideoInfo(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateReplyReply{ReplyID: reply.ReplyID}, nil
+}
+
+// DeleteReply 商家撤回回复
+func (s *ReviewService) DeleteReply(ctx context.Context, req *pb.DeleteReplyRequest) (*pb.DeleteReplyReply, error) {
+	err := s.uc.DeleteReply(ctx, &biz.DeleteReplyParam{
+		ReplyID: req.GetReplyID(),
+		StoreID: req.GetStoreID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteReplyReply{}, nil
+}
+
+// ListRepliesByStoreID 商家后台查询店铺的回复
+func (s *ReviewService) ListRepliesByStoreID(ctx context.Context, req *pb.ListRepliesByStoreIDRequest) (*pb.ListRepliesByStoreIDReply, error) {
+	replies, total, err := s.uc.ListReplyByStoreID(ctx, req.GetStoreID(), int(req.GetPage()), int(req.GetSize()))
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.ReplyInfo, 0, len(replies))
+	for _, r := range replies {
+		list = append(list, replyInfoFromModel(r))
+	}
+	return &pb.ListRepliesByStoreIDReply{List: list, Total: total}, nil
+}
+
+// AuditReview O端审核评价
+func (s *ReviewService) AuditReview(ctx context.Context, req *pb.AuditReviewRequest) (*pb.AuditReviewReply, error) {
+	err := s.uc.AuditReview(ctx, &biz.AuditParam{
+		ReviewID:  req.GetReviewID(),
+		OpReason:  req.GetOpReason(),
+		OpRemarks: req.GetOpRemarks(),
+		Status:    req.GetStatus(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AuditReviewReply{
+		ReviewID: req.GetReviewID(),
+		Status:   req.GetStatus(),
+	}, nil
+}
+
+// ListPendingAudits 查询待审核的评价或申诉
+func (s *ReviewService) ListPendingAudits(ctx context.Context, req *pb.ListPendingAuditsRequest) (*pb.ListPendingAuditsReply, error) {
+	tasks, total, err := s.uc.ListPendingAudits(ctx, req.GetTaskType(), int(req.GetPage()), int(req.GetSize()))
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.AuditTask, 0, len(tasks))
+	for _, t := range tasks {
+		list = append(list, auditTaskFromBiz(t))
+	}
+	return &pb.ListPendingAuditsReply{List: list, Total: total}, nil
+}
+
+// ClaimAuditTask 领取审核任务
+func (s *ReviewService) ClaimAuditTask(ctx context.Context, req *pb.ClaimAuditTaskRequest) (*pb.ClaimAuditTaskReply, error) {
+	tasks, err := s.uc.ClaimAuditTask(ctx, req.GetTaskType(), int(req.GetCount()))
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.AuditTask, 0, len(tasks))
+	for _, t := range tasks {
+		list = append(list, auditTaskFromBiz(t))
+	}
+	return &pb.ClaimAuditTaskReply{List: list}, nil
+}
+
+// auditTaskFromBiz 将审核任务转换成返回给调用方的结构
+func auditTaskFromBiz(t *biz.AuditTask) *pb.AuditTask {
+	task := &pb.AuditTask{
+		TaskType: t.TaskType,
+		TaskID:   t.TaskID,
+		ReviewID: t.ReviewID,
+		StoreID:  t.StoreID,
+		Content:  t.Content,
+		CreateAt: t.CreateAt.Unix(),
+	}
+	if t.Lease != nil {
+		task.LeaseOwner = t.Lease.Owner
+		task.LeaseExpireAt = t.Lease.ExpireAt.Unix()
+	}
+	return task
+}
+
+// ListOperationLogs 查询操作日志
+func (s *ReviewService) ListOperationLogs(ctx context.Context, req *pb.ListOperationLogsRequest) (*pb.ListOperationLogsReply, error) {
+	param := &biz.ListOperationLogParam{
+		ReviewID: req.GetReviewID(),
+		Actor:    req.GetActor(),
+		Page:     int(req.GetPage()),
+		Size:     int(req.GetSize()),
+	}
+	if req.GetStartTime() > 0 {
+		param.StartTime = time.Unix(req.GetStartTime(), 0)
+	}
+	if req.GetEndTime() > 0 {
+		param.EndTime = time.Unix(req.GetEndTime(), 0)
+	}
+	logs, total, err := s.uc.ListOperationLogs(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.OperationLog, 0, len(logs))
+	for _, l := range logs {
+		list = append(list, &pb.OperationLog{
+			Id:         l.ID,
+			ReviewID:   l.ReviewID,
+			AppealID:   l.AppealID,
+			TargetType: l.TargetType,
+			Action:     l.Action,
+			Actor:      l.Actor,
+			OldStatus:  l.OldStatus,
+			NewStatus:  l.NewStatus,
+			Reason:     l.Reason,
+			Remarks:    l.Remarks,
+			CreateAt:   l.CreateAt.Unix(),
+		})
+	}
+	return &pb.ListOperationLogsReply{List: list, Total: total}, nil
+}
+
+// UpdateReview 用户修改评价
+func (s *ReviewService) UpdateReview(ctx context.Context, req *pb.UpdateReviewRequest) (*pb.UpdateReviewReply, error) {
+	status, err := s.uc.UpdateReview(ctx, &biz.UpdateReviewParam{
+		ReviewID:     req.GetReviewID(),
+		UserID:       req.GetUserID(),
+		Score:        req.GetScore(),
+		ServiceScore: req.GetServiceScore(),
+		ExpressScore: req.GetExpressScore(),
+		Content:      req.GetContent(),
+		PicInfo:      req.GetPicInfo(),
+		VideoInfo:    req.GetVideoInfo(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateReviewReply{ReviewID: req.GetReviewID(), Status: status}, nil
+}
+
+// ListReviewHistory 查询评价修改历史
+func (s *ReviewService) ListReviewHistory(ctx context.Context, req *pb.ListReviewHistoryRequest) (*pb.ListReviewHistoryReply, error) {
+	list, err := s.uc.ListReviewHistory(ctx, req.GetReviewID())
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListReviewHistoryReply{List: make([]*pb.ReviewHistoryInfo, 0, len(list))}
+	for _, h := range list {
+		reply.List = append(reply.List, &pb.ReviewHistoryInfo{
+			ReviewID:     h.ReviewID,
+			Version:      h.ReviewVersion,
+			Score:        h.Score,
+			ServiceScore: h.ServiceScore,
+			ExpressScore: h.ExpressScore,
+			Content:      h.Content,
+			PicInfo:      h.PicInfo,
+			VideoInfo:    h.VideoInfo,
+			Status:       h.Status,
+			UpdateBy:     h.CreateBy,
+			CreateAt:     h.CreateAt.Unix(),
+		})
+	}
+	return reply, nil
+}
+
+// AppendReview 用户追评
+func (s *ReviewService) AppendReview(ctx context.Context, req *pb.AppendReviewRequest) (*pb.AppendReviewReply, error) {
+	ret, err := s.uc.AppendReview(ctx, &biz.AppendParam{
+		ReviewID:  req.GetReviewID(),
+		UserID:    req.GetUserID(),
+		Content:   req.GetContent(),
+		PicInfo:   req.GetPicInfo(),
+		VideoInfo: req.GetVideoInfo(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AppendReviewReply{AppendID: ret.AppendID}, nil
+}
+
+// AuditAppendReview 运营审核追评
+func (s *ReviewService) AuditAppendReview(ctx context.Context, req *pb.AuditAppendReviewRequest) (*pb.AuditAppendReviewReply, error) {
+	err := s.uc.AuditAppend(ctx, &biz.AuditAppendParam{
+		ReviewID:  req.GetReviewID(),
+		OpReason:  req.GetOpReason(),
+		OpRemarks: req.GetOpRemarks(),
+		Status:    req.GetStatus(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AuditAppendReviewReply{
+		ReviewID: req.GetReviewID(),
+		Status:   req.GetStatus(),
+	}, nil
+}
+
+// DeleteReview 删除评价
+func (s *ReviewService) DeleteReview(ctx context.Context, req *pb.DeleteReviewRequest) (*pb.DeleteReviewReply, error) {
+	// 操作人从网关传入的调用方身份获取，忽略请求中的userID和opUser
+	err := s.uc.DeleteReview(ctx, &biz.DeleteReviewParam{ReviewID: req.GetReviewID()})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteReviewReply{ReviewID: req.GetReviewID()}, nil
+}
+
+// RestoreReview 恢复已删除的评价
+func (s *ReviewService) RestoreReview(ctx context.Context, req *pb.RestoreReviewRequest) (*pb.RestoreReviewReply, error) {
+	// 操作人从网关传入的调用方身份获取，忽略请求中的userID和opUser
+	err := s.uc.RestoreReview(ctx, &biz.DeleteReviewParam{ReviewID: req.GetReviewID()})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreReviewReply{ReviewID: req.GetReviewID()}, nil
+}
+
 // AppealReview 申述评价
 func (s *ReviewService) AppealReview(ctx context.Context, req *pb.AppealReviewRequest) (*pb.AppealReviewReply, error) {
-	fmt.Printf("[service] AppealReview req :%#v\n", req)
 	ret, err := s.uc.AppealReview(ctx, &biz.AppealParam{
 		ReviewID:  req.GetReviewID(),
 		StoreID:   req.GetStoreID(),
@@ -108,18 +430,17 @@ func (s *ReviewService) AppealReview(ctx context.Context, req *pb.AppealReviewRe
 	if err != nil {
 		return nil, err
 	}
-	fmt.Printf("[service AppealReview ret:%v err:%v\n]", ret, err)
 	return &pb.AppealReviewReply{AppealID: ret.AppealID}, nil
 }
 
 // AuditAppeal O短审核评价
 func (s *ReviewService) AuditAppeal(ctx context.Context, req *pb.AuditAppealRequest) (*pb.AuditAppealReply, error) {
-	fmt.Printf("[service] AuditAppeal req:%#v\n", req)
 	err := s.uc.AuditAppeal(ctx, &biz.AuditAppealParam{
-		ReviewID: req.GetReviewID(),
-		AppealID: req.GetAppealID(),
-		OpUser:   req.GetOpUser(),
-		Status:   req.GetStatus(),
+		ReviewID:  req.GetReviewID(),
+		AppealID:  req.GetAppealID(),
+		Status:    req.GetStatus(),
+		OpReason:  req.GetOpReason(),
+		OpRemarks: req.GetOpRemarks(),
 	})
 	if err != nil {
 		return nil, err
@@ -127,29 +448,311 @@ func (s *ReviewService) AuditAppeal(ctx context.Context, req *pb.AuditAppealRequ
 	return &pb.AuditAppealReply{}, nil
 }
 
+// WithdrawAppeal 商家撤回申诉
+func (s *ReviewService) WithdrawAppeal(ctx context.Context, req *pb.WithdrawAppealRequest) (*pb.WithdrawAppealReply, error) {
+	if err := s.uc.WithdrawAppeal(ctx, req.GetAppealID(), req.GetStoreID()); err != nil {
+		return nil, err
+	}
+	return &pb.WithdrawAppealReply{}, nil
+}
+
+// ListAppealsByStoreID 商家查询店铺的申诉
+func (s *ReviewService) ListAppealsByStoreID(ctx context.Context, req *pb.ListAppealsByStoreIDRequest) (*pb.ListAppealsByStoreIDReply, error) {
+	appeals, total, err := s.uc.ListAppealsByStoreID(ctx, &biz.ListAppealParam{
+		StoreID: req.GetStoreID(),
+		Status:  req.GetStatus(),
+		Page:    int(req.GetPage()),
+		Size:    int(req.GetSize()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListAppealsByStoreIDReply{List: appealListFromModel(appeals), Total: total}, nil
+}
+
+// ListAppeals 运营按条件查询申诉
+func (s *ReviewService) ListAppeals(ctx context.Context, req *pb.ListAppealsRequest) (*pb.ListAppealsReply, error) {
+	param := &biz.ListAppealParam{
+		StoreID: req.GetStoreID(),
+		Status:  req.GetStatus(),
+		Reason:  req.GetReason(),
+		Page:    int(req.GetPage()),
+		Size:    int(req.GetSize()),
+	}
+	if req.GetStartTime() > 0 {
+		param.StartTime = time.Unix(req.GetStartTime(), 0)
+	}
+	if req.GetEndTime() > 0 {
+		param.EndTime = time.Unix(req.GetEndTime(), 0)
+	}
+	appeals, total, err := s.uc.ListAppeals(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListAppealsReply{List: appealListFromModel(appeals), Total: total}, nil
+}
+
+// ListAppealReasons 查询申诉原因
+func (s *ReviewService) ListAppealReasons(ctx context.Context, req *pb.ListAppealReasonsRequest) (*pb.ListAppealReasonsReply, error) {
+	reasons := s.uc.ListAppealReasons()
+	list := make([]*pb.AppealReason, 0, len(reasons))
+	for _, r := range reasons {
+		list = append(list, &pb.AppealReason{
+			Code:           r.Code,
+			Label:          r.Label(req.GetLang()),
+			RequireContent: r.RequireContent,
+			RequirePicture: r.RequirePicture,
+			RequireVideo:   r.RequireVideo,
+		})
+	}
+	return &pb.ListAppealReasonsReply{List: list}, nil
+}
+
+// GetAppealReasonStats 按申诉原因统计申诉
+func (s *ReviewService) GetAppealReasonStats(ctx context.Context, req *pb.GetAppealReasonStatsRequest) (*pb.GetAppealReasonStatsReply, error) {
+	param := &biz.AppealReasonStatParam{StoreID: req.GetStoreID()}
+	if req.GetStartTime() > 0 {
+		param.StartTime = time.Unix(req.GetStartTime(), 0)
+	}
+	if req.GetEndTime() > 0 {
+		param.EndTime = time.Unix(req.GetEndTime(), 0)
+	}
+	stats, err := s.uc.GetAppealReasonStats(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.AppealReasonStat, 0, len(stats))
+	for _, st := range stats {
+		list = append(list, &pb.AppealReasonStat{
+			Code:      st.Reason.Code,
+			Label:     st.Reason.Label(req.GetLang()),
+			Total:     st.Total,
+			Pending:   st.Pending,
+			Approved:  st.Approved,
+			Rejected:  st.Rejected,
+			Withdrawn: st.Withdrawn,
+		})
+	}
+	return &pb.GetAppealReasonStatsReply{List: list}, nil
+}
+
+// ListAppealHistory 查询评价的全部申诉记录
+func (s *ReviewService) ListAppealHistory(ctx context.Context, req *pb.ListAppealHistoryRequest) (*pb.ListAppealHistoryReply, error) {
+	appeals, err := s.uc.ListAppealHistory(ctx, &biz.GetReviewParam{ReviewID: req.GetReviewID()})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListAppealHistoryReply{List: appealListFromModel(appeals)}, nil
+}
+
 // ListReviewByStoreID 根据商家ID查询评价
 func (s *ReviewService) ListReviewByStoreID(ctx context.Context, req *pb.ListReviewByStoreIDRequest) (*pb.ListReviewByStoreIDReply, error) {
-	fmt.Printf("[service] ListReviewByStoreID req:%#v\n", req)
-	reviewList, err := s.uc.ListReviewByStoreID(ctx, req.StoreID, int(req.Page), int(req.Size))
+	param := &biz.ListReviewParam{
+		StoreID:    req.GetStoreID(),
+		SpuID:      req.GetSpuID(),
+		SkuID:      req.GetSkuID(),
+		MinScore:   req.GetMinScore(),
+		MaxScore:   req.GetMaxScore(),
+		HasMedia:   req.HasMedia,
+		HasReply:   req.HasReply,
+		Status:     req.Status,
+		Anonymous:  req.Anonymous,
+		HasContent: req.HasContent,
+		Sort:       int32(req.GetSort()),
+		Page:       int(req.GetPage()),
+		Size:       int(req.GetSize()),
+	}
+	if req.GetStartTime() > 0 {
+		param.StartTime = time.Unix(req.GetStartTime(), 0)
+	}
+	if req.GetEndTime() > 0 {
+		param.EndTime = time.Unix(req.GetEndTime(), 0)
+	}
+	reviewList, total, err := s.uc.ListReviewByStoreID(ctx, param)
 	if err != nil {
 		return nil, err
 	}
 	//格式化数据，构建Reply
 	list := make([]*pb.ReviewInfo, 0, len(reviewList))
 	for _, r := range reviewList {
-		list = append(list, &pb.ReviewInfo{
-			ReviewID:     r.ReviewID,
-			UserID:       r.UserID,
-			OrderID:      r.OrderID,
-			Score:        r.Score,
-			ServiceScore: r.ServiceScore,
-			ExpressScore: r.ExpressScore,
-			Content:      r.Content,
-			PicInfo:      r.PicInfo,
-			VideoInfo:    r.VideoInfo,
-			Status:       r.Status,
-		})
+		list = append(list, toReviewInfo(r))
+	}
+
+	return &pb.ListReviewByStoreIDReply{List: list, Total: total}, nil
+}
+
+// ListReviewBySpu C端商品详情页查询评价
+func (s *ReviewService) ListReviewBySpu(ctx context.Context, req *pb.ListReviewBySpuRequest) (*pb.ListReviewBySpuReply, error) {
+	page, err := s.uc.ListReviewBySpu(ctx, &biz.ListSpuReviewParam{
+		SpuID:       req.GetSpuID(),
+		SkuID:       req.GetSkuID(),
+		OnlyMedia:   req.GetOnlyMedia(),
+		OnlyContent: req.GetOnlyContent(),
+		ScoreLevel:  int32(req.GetScoreLevel()),
+		Cursor:      req.GetCursor(),
+		Size:        int(req.GetSize()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.ReviewInfo, 0, len(page.List))
+	for _, r := range page.List {
+		list = append(list, toReviewInfo(r))
+	}
+	return &pb.ListReviewBySpuReply{
+		List:       list,
+		Total:      page.Total,
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+// toReviewInfo 将ES中查询到的评价转换成返回给调用方的结构
+func toReviewInfo(r *biz.MyReviewInfo) *pb.ReviewInfo {
+	info := &pb.ReviewInfo{
+		ReviewID:     r.ReviewID,
+		UserID:       r.UserID,
+		OrderID:      r.OrderID,
+		Score:        r.Score,
+		ServiceScore: r.ServiceScore,
+		ExpressScore: r.ExpressScore,
+		Status:       r.Status,
+		SkuID:        r.SkuID,
+		SpuID:        r.SpuID,
+		StoreID:      r.StoreID,
+		Anonymous:    r.Anonymous,
+		HasMedia:     r.HasMedia,
+		HasReply:     hasReply(r.HasReply),
+		IsDefault:    r.IsDefault,
+		CreateAt:     time.Time(r.CreateAt).Unix(),
+		UpdateAt:     time.Time(r.UpdateAt).Unix(),
+	}
+	if r.ReviewInfo != nil {
+		info.Content = r.Content
+		info.PicInfo = r.PicInfo
+		info.VideoInfo = r.VideoInfo
+		info.Tags = r.Tags
+		info.GoodsSnapshoot = r.GoodsSnapshoot
+	}
+	if r.Append != nil {
+		info.Append = &pb.AppendInfo{
+			AppendID:  r.Append.AppendID,
+			ReviewID:  r.ReviewID,
+			Content:   r.Append.Content,
+			PicInfo:   r.Append.PicInfo,
+			VideoInfo: r.Append.VideoInfo,
+			Status:    biz.AppendStatusApproved,
+			CreateAt:  time.Time(r.Append.CreateAt).Unix(),
+		}
+	}
+	return info
+}
+
+// ListReviewByUserID C端用户中心查询自己的评价
+func (s *ReviewService) ListReviewByUserID(ctx context.Context, req *pb.ListReviewByUserIDRequest) (*pb.ListReviewByUserIDReply, error) {
+	reviews, total, err := s.uc.ListReviewByUserID(ctx, req.GetUserID(), int(req.GetPage()), int(req.GetSize()))
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.ReviewInfo, 0, len(reviews))
+	for _, r := range reviews {
+		info := reviewInfoFromModel(r.ReviewInfo)
+		info.OpReason = r.OpReason
+		if r.Reply != nil {
+			info.Reply = replyInfoFromModel(r.Reply)
+		}
+		if r.Append != nil {
+			info.Append = appendInfoFromModel(r.Append)
+		}
+		list = append(list, info)
+	}
+	return &pb.ListReviewByUserIDReply{List: list, Total: total}, nil
+}
+
+// reviewInfoFromModel 将数据库中的评价转换成返回给调用方的结构
+func reviewInfoFromModel(r *model.ReviewInfo) *pb.ReviewInfo {
+	return &pb.ReviewInfo{
+		ReviewID:       r.ReviewID,
+		UserID:         r.UserID,
+		OrderID:        r.OrderID,
+		Score:          r.Score,
+		ServiceScore:   r.ServiceScore,
+		ExpressScore:   r.ExpressScore,
+		Content:        r.Content,
+		PicInfo:        r.PicInfo,
+		VideoInfo:      r.VideoInfo,
+		Status:         r.Status,
+		SkuID:          r.SkuID,
+		SpuID:          r.SpuID,
+		StoreID:        r.StoreID,
+		Tags:           r.Tags,
+		Anonymous:      r.Anonymous,
+		HasMedia:       r.HasMedia,
+		HasReply:       hasReply(r.HasReply),
+		IsDefault:      r.IsDefault,
+		GoodsSnapshoot: r.GoodsSnapshoot,
+		CreateAt:       r.CreateAt.Unix(),
+		UpdateAt:       r.UpdateAt.Unix(),
+	}
+}
+
+// hasReply 回复已撤回的评价对外按没有回复返回
+func hasReply(state int32) int32 {
+	if state == biz.ReplyStateReplied {
+		return 1
 	}
+	return 0
+}
 
-	return &pb.ListReviewByStoreIDReply{List: list}, nil
+// replyInfoFromModel 将数据库中的商家回复转换成返回给调用方的结构
+func replyInfoFromModel(r *model.ReviewReplyInfo) *pb.ReplyInfo {
+	return &pb.ReplyInfo{
+		ReplyID:   r.ReplyID,
+		ReviewID:  r.ReviewID,
+		StoreID:   r.StoreID,
+		Content:   r.Content,
+		PicInfo:   r.PicInfo,
+		VideoInfo: r.VideoInfo,
+		CreateAt:  r.CreateAt.Unix(),
+	}
+}
+
+// appendInfoFromModel 将数据库中的追评转换成返回给调用方的结构
+func appendInfoFromModel(a *model.ReviewAppendInfo) *pb.AppendInfo {
+	return &pb.AppendInfo{
+		AppendID:  a.AppendID,
+		ReviewID:  a.ReviewID,
+		Content:   a.Content,
+		PicInfo:   a.PicInfo,
+		VideoInfo: a.VideoInfo,
+		Status:    a.Status,
+		OpReason:  a.OpReason,
+		CreateAt:  a.CreateAt.Unix(),
+	}
+}
+
+// appealInfoFromModel 将数据库中的申诉转换成返回给调用方的结构
+func appealInfoFromModel(a *model.ReviewAppealInfo) *pb.AppealInfo {
+	return &pb.AppealInfo{
+		AppealID:  a.AppealID,
+		ReviewID:  a.ReviewID,
+		StoreID:   a.StoreID,
+		Status:    a.Status,
+		Reason:    a.Reason,
+		Content:   a.Content,
+		PicInfo:   a.PicInfo,
+		VideoInfo: a.VideoInfo,
+		OpReason:  a.OpReason,
+		OpRemarks: a.OpRemarks,
+		OpUser:    a.OpUser,
+		CreateAt:  a.CreateAt.Unix(),
+		UpdateAt:  a.UpdateAt.Unix(),
+	}
+}
+
+func appealListFromModel(appeals []*model.ReviewAppealInfo) []*pb.AppealInfo {
+	list := make([]*pb.AppealInfo, 0, len(appeals))
+	for _, a := range appeals {
+		list = append(list, appealInfoFromModel(a))
+	}
+	return list
 }
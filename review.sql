@@ -27,7 +27,7 @@ CREATE TABLE review_info (
         `video_info` varchar(1024) NOT NULL DEFAULT '' COMMENT '媒体信息：视频',
         `status` tinyint(4) NOT NULL DEFAULT '10' COMMENT '状态:10待审核；20审核通过；30审核不通过；40隐藏',
         `is_default` tinyint(4) NOT NULL DEFAULT '0' COMMENT '是否默认评价',
-        `has_reply` tinyint(4) NOT NULL DEFAULT '0' COMMENT '是否有商家回复:0⽆;1有',
+        `has_reply` tinyint(4) NOT NULL DEFAULT '0' COMMENT '是否有商家回复:0⽆;1有;2回复已撤回',
         `op_reason` varchar(512) NOT NULL DEFAULT '' COMMENT '运营审核拒绝原因',
         `op_remarks` varchar(512) NOT NULL DEFAULT '' COMMENT '运营备注',
         `op_user` varchar(64) NOT NULL DEFAULT '' COMMENT '运营者标识',
@@ -37,8 +37,10 @@ CREATE TABLE review_info (
         PRIMARY KEY (`id`),
         KEY `idx_delete_at` (`delete_at`) COMMENT '逻辑删除索引',
         UNIQUE KEY `uk_review_id` (`review_id`) COMMENT '评价id索引',
-        KEY `idx_order_id` (`order_id`) COMMENT '订单id索引',
-        KEY `idx_user_id` (`user_id`) COMMENT '⽤户id索引'
+        UNIQUE KEY `uk_order_sku` (`order_id`,`sku_id`) COMMENT '订单中每个商品只能评价一次',
+        KEY `idx_user_id` (`user_id`) COMMENT '⽤户id索引',
+        KEY `idx_status` (`status`) COMMENT '状态索引，用于查询待审核的评价',
+        KEY `idx_store_reply` (`store_id`,`has_reply`) COMMENT '店铺未回复评价索引，用于自动回复'
  ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价表';
 
 
@@ -81,12 +83,12 @@ CURRENT_TIMESTAMP COMMENT '更新时间',
         `appeal_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '回复id',
         `review_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '评价id',
         `store_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '店铺id',
-        `status` tinyint(4) NOT NULL DEFAULT '10' COMMENT '状态:10待审核；20申诉通过；30申诉
-        驳回',
+        `status` tinyint(4) NOT NULL DEFAULT '10' COMMENT '状态:10待审核；20申诉通过；30申诉驳回；40已撤回',
         `reason` varchar(255) NOT NULL COMMENT '申诉原因类别',
         `content` varchar(255) NOT NULL COMMENT '申诉内容描述',
         `pic_info` varchar(1024) NOT NULL DEFAULT '' COMMENT '媒体信息：图⽚',
         `video_info` varchar(1024) NOT NULL DEFAULT '' COMMENT '媒体信息：视频',
+        `op_reason` varchar(512) NOT NULL DEFAULT '' COMMENT '运营审核原因',
         `op_remarks` varchar(512) NOT NULL DEFAULT '' COMMENT '运营备注',
         `op_user` varchar(64) NOT NULL DEFAULT '' COMMENT '运营者标识',
         `ext_json` varchar(1024) NOT NULL DEFAULT '' COMMENT '信息扩展',
@@ -94,6 +96,149 @@ CURRENT_TIMESTAMP COMMENT '更新时间',
         PRIMARY KEY (`id`),
         KEY `idx_delete_at` (`delete_at`) COMMENT '逻辑删除索引',
         KEY `idx_appeal_id` (`appeal_id`) COMMENT '申诉id索引',
-        UNIQUE KEY `uk_review_id` (`review_id`) COMMENT '评价id索引',
+        KEY `idx_review_id` (`review_id`) COMMENT '评价id索引，每次提交申诉都是一条新记录',
+        KEY `idx_store_id` (`store_id`) COMMENT '店铺id索引',
+        KEY `idx_status` (`status`) COMMENT '状态索引，用于查询待审核的申诉'
+        )ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价商家申诉表';
+
+
+  CREATE TABLE review_history (
+        `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+        `create_by` varchar(48) NOT NULL DEFAULT '' COMMENT '创建⽅标识',
+        `update_by` varchar(48) NOT NULL DEFAULT '' COMMENT '更新⽅标识',
+        `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+        `update_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE
+        CURRENT_TIMESTAMP COMMENT '更新时间',
+        `delete_at` timestamp COMMENT '逻辑删除标记',
+        `version` int(10) unsigned NOT NULL DEFAULT '0' COMMENT '乐观锁标记',
+        `review_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '评价id',
+        `review_version` int(10) unsigned NOT NULL DEFAULT '0' COMMENT '修改前评价的版本号',
+        `content` varchar(512) NOT NULL COMMENT '修改前的评价内容',
+        `score` tinyint(4) NOT NULL DEFAULT '0' COMMENT '修改前的评分',
+        `service_score` tinyint(4) NOT NULL DEFAULT '0' COMMENT '修改前的商家服务评分',
+        `express_score` tinyint(4) NOT NULL DEFAULT '0' COMMENT '修改前的物流评分',
+        `pic_info` varchar(1024) NOT NULL DEFAULT '' COMMENT '修改前的媒体信息：图⽚',
+        `video_info` varchar(1024) NOT NULL DEFAULT '' COMMENT '修改前的媒体信息：视频',
+        `status` tinyint(4) NOT NULL DEFAULT '10' COMMENT '修改前的评价状态',
+        `ext_json` varchar(1024) NOT NULL DEFAULT '' COMMENT '信息扩展',
+        `ctrl_json` varchar(1024) NOT NULL DEFAULT '' COMMENT '控制扩展',
+        PRIMARY KEY (`id`),
+        KEY `idx_delete_at` (`delete_at`) COMMENT '逻辑删除索引',
+        KEY `idx_review_id` (`review_id`) COMMENT '评价id索引'
+        )ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价修改历史表';
+
+
+
+  CREATE TABLE review_append_info (
+        `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+        `create_by` varchar(48) NOT NULL DEFAULT '' COMMENT '创建⽅标识',
+        `update_by` varchar(48) NOT NULL DEFAULT '' COMMENT '更新⽅标识',
+        `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+        `update_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE
+        CURRENT_TIMESTAMP COMMENT '更新时间',
+        `delete_at` timestamp COMMENT '逻辑删除标记',
+        `version` int(10) unsigned NOT NULL DEFAULT '0' COMMENT '乐观锁标记',
+        `append_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '追评id',
+        `review_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '评价id',
+        `user_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '⽤户id',
+        `store_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '店铺id',
+        `content` varchar(512) NOT NULL COMMENT '追评内容',
+        `pic_info` varchar(1024) NOT NULL DEFAULT '' COMMENT '媒体信息：图⽚',
+        `video_info` varchar(1024) NOT NULL DEFAULT '' COMMENT '媒体信息：视频',
+        `status` tinyint(4) NOT NULL DEFAULT '10' COMMENT '状态:10待审核；20审核通过；30审核不通过',
+        `op_reason` varchar(512) NOT NULL DEFAULT '' COMMENT '运营审核拒绝原因',
+        `op_remarks` varchar(512) NOT NULL DEFAULT '' COMMENT '运营备注',
+        `op_user` varchar(64) NOT NULL DEFAULT '' COMMENT '运营者标识',
+        `ext_json` varchar(1024) NOT NULL DEFAULT '' COMMENT '信息扩展',
+        `ctrl_json` varchar(1024) NOT NULL DEFAULT '' COMMENT '控制扩展',
+        PRIMARY KEY (`id`),
+        KEY `idx_delete_at` (`delete_at`) COMMENT '逻辑删除索引',
+        UNIQUE KEY `uk_append_id` (`append_id`) COMMENT '追评id索引',
+        UNIQUE KEY `uk_review_id` (`review_id`) COMMENT '评价id索引'
+        )ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价追评表';
+
+
+  CREATE TABLE review_operation_log (
+        `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+        `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '操作时间',
+        `review_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '评价id',
+        `appeal_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '申诉id，操作对象是评价时为0',
+        `target_type` tinyint(4) NOT NULL DEFAULT '1' COMMENT '操作对象:1评价；2申诉；3追评',
+        `action` varchar(32) NOT NULL DEFAULT '' COMMENT '操作类型',
+        `actor` varchar(64) NOT NULL DEFAULT '' COMMENT '操作人标识',
+        `old_status` tinyint(4) NOT NULL DEFAULT '0' COMMENT '操作前的状态，新建时为0',
+        `new_status` tinyint(4) NOT NULL DEFAULT '0' COMMENT '操作后的状态',
+        `reason` varchar(512) NOT NULL DEFAULT '' COMMENT '操作原因',
+        `remarks` varchar(512) NOT NULL DEFAULT '' COMMENT '操作备注',
+        `ext_json` varchar(1024) NOT NULL DEFAULT '' COMMENT '信息扩展',
+        PRIMARY KEY (`id`),
+        KEY `idx_review_id` (`review_id`) COMMENT '评价id索引',
+        KEY `idx_actor_create_at` (`actor`,`create_at`) COMMENT '操作人索引',
+        KEY `idx_create_at` (`create_at`) COMMENT '操作时间索引'
+        )ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价操作日志表，只追加不修改';
+
+
+  CREATE TABLE review_reply_template (
+        `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+        `create_by` varchar(48) NOT NULL DEFAULT '' COMMENT '创建⽅标识',
+        `update_by` varchar(48) NOT NULL DEFAULT '' COMMENT '更新⽅标识',
+        `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+        `update_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE
+        CURRENT_TIMESTAMP COMMENT '更新时间',
+        `delete_at` timestamp COMMENT '逻辑删除标记',
+        `version` int(10) unsigned NOT NULL DEFAULT '0' COMMENT '乐观锁标记',
+        `template_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '模板id',
+        `store_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '店铺id',
+        `name` varchar(64) NOT NULL DEFAULT '' COMMENT '模板名称',
+        `content` varchar(512) NOT NULL COMMENT '模板内容，支持{nickname}和{product}占位符',
+        `ext_json` varchar(1024) NOT NULL DEFAULT '' COMMENT '信息扩展',
+        `ctrl_json` varchar(1024) NOT NULL DEFAULT '' COMMENT '控制扩展',
+        PRIMARY KEY (`id`),
+        KEY `idx_delete_at` (`delete_at`) COMMENT '逻辑删除索引',
+        UNIQUE KEY `uk_template_id` (`template_id`) COMMENT '模板id索引',
         KEY `idx_store_id` (`store_id`) COMMENT '店铺id索引'
-        )ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评价商家申诉表';
\ No newline at end of file
+        )ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='商家回复模板表';
+
+
+  CREATE TABLE review_auto_reply_rule (
+        `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+        `create_by` varchar(48) NOT NULL DEFAULT '' COMMENT '创建⽅标识',
+        `update_by` varchar(48) NOT NULL DEFAULT '' COMMENT '更新⽅标识',
+        `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+        `update_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE
+        CURRENT_TIMESTAMP COMMENT '更新时间',
+        `delete_at` timestamp COMMENT '逻辑删除标记',
+        `version` int(10) unsigned NOT NULL DEFAULT '0' COMMENT '乐观锁标记',
+        `rule_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '规则id',
+        `store_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '店铺id',
+        `template_id` bigint(32) NOT NULL DEFAULT '0' COMMENT '回复使用的模板id',
+        `min_score` tinyint(4) NOT NULL DEFAULT '1' COMMENT '最低评分',
+        `max_score` tinyint(4) NOT NULL DEFAULT '5' COMMENT '最高评分',
+        `content_type` tinyint(4) NOT NULL DEFAULT '0' COMMENT '评价内容:0不限；1没有内容（默认好评）；2有内容',
+        `delay` int(10) unsigned NOT NULL DEFAULT '0' COMMENT '评价发布多少秒后自动回复',
+        `priority` int(10) NOT NULL DEFAULT '0' COMMENT '优先级，越小越优先',
+        `enable` tinyint(4) NOT NULL DEFAULT '0' COMMENT '是否启用',
+        `ext_json` varchar(1024) NOT NULL DEFAULT '' COMMENT '信息扩展',
+        `ctrl_json` varchar(1024) NOT NULL DEFAULT '' COMMENT '控制扩展',
+        PRIMARY KEY (`id`),
+        KEY `idx_delete_at` (`delete_at`) COMMENT '逻辑删除索引',
+        UNIQUE KEY `uk_rule_id` (`rule_id`) COMMENT '规则id索引',
+        KEY `idx_store_id` (`store_id`) COMMENT '店铺id索引'
+        )ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='商家自动回复规则表';
+
+
+  CREATE TABLE review_event_outbox (
+        `id` bigint(32) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键',
+        `create_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+        `update_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE
+        CURRENT_TIMESTAMP COMMENT '更新时间',
+        `event_type` varchar(32) NOT NULL DEFAULT '' COMMENT '事件类型',
+        `event_key` bigint(32) NOT NULL DEFAULT '0' COMMENT '事件的接收方，比如商家事件是店铺id',
+        `payload` varchar(2048) NOT NULL DEFAULT '' COMMENT '事件内容json',
+        `status` tinyint(4) NOT NULL DEFAULT '10' COMMENT '状态:10待发送；20已发送',
+        `retry_count` int(10) unsigned NOT NULL DEFAULT '0' COMMENT '发送失败次数',
+        `next_retry_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '下次发送时间',
+        `last_error` varchar(512) NOT NULL DEFAULT '' COMMENT '最近一次发送失败的原因',
+        PRIMARY KEY (`id`),
+        KEY `idx_status_next_retry_at` (`status`,`next_retry_at`) COMMENT '待发送事件索引'
+        )ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='业务事件发件箱表，和业务数据在同一个事务中写入，由后台任务发送';